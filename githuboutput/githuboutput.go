@@ -1,12 +1,23 @@
 package githuboutput
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 )
 
+var (
+	// ErrGitHubOutputUnset is returned when the GITHUB_OUTPUT environment variable is not set,
+	// which is expected when running outside of GitHub Actions.
+	ErrGitHubOutputUnset = errors.New("GITHUB_OUTPUT is not set")
+	// ErrInvalidOutputName is returned when the output name is empty or contains '\r', '\n' or '='.
+	ErrInvalidOutputName = errors.New("invalid output name")
+	// ErrInvalidOutputValue is returned when a single-line output value contains '\r' or '\n'.
+	ErrInvalidOutputValue = errors.New("invalid output value")
+)
+
 // WriteToGitHubOutput appends a single-line output in "name=value" format
 // to the file pointed to by the GITHUB_OUTPUT environment variable.
 //
@@ -17,22 +28,56 @@ import (
 //   - value must be single-line (no '\r' or '\n')
 //
 // Returns true on success, false on validation or I/O failure.
+// I/O failures are logged; use WriteToGitHubOutputErr to handle them instead.
 func WriteToGitHubOutput(name, value string) bool {
+	return reportWriteError(WriteToGitHubOutputErr(name, value))
+}
+
+// WriteToGitHubOutputErr works like WriteToGitHubOutput but returns the reason for a failure:
+//   - ErrGitHubOutputUnset if GITHUB_OUTPUT is not set
+//   - an error wrapping ErrInvalidOutputName or ErrInvalidOutputValue on validation failure
+//   - an error wrapping the underlying os error if the file cannot be opened, written or closed
+//
+// Callers can tell the cases apart with errors.Is, e.g. to ignore a missing
+// GITHUB_OUTPUT when running locally.
+func WriteToGitHubOutputErr(name, value string) error {
 	githubOutput, ok := githubOutputPath()
 	if !ok {
-		return false
+		return ErrGitHubOutputUnset
 	}
 
-	name, ok = normalizeOutputName(name)
+	normalized, ok := normalizeOutputName(name)
 	if !ok {
-		return false
+		return fmt.Errorf("%w: %q", ErrInvalidOutputName, name)
 	}
 
 	if !isSingleLineValue(value) {
+		return fmt.Errorf("%w: value for %q must be single-line", ErrInvalidOutputValue, normalized)
+	}
+
+	return appendOutput(githubOutput, normalized, normalized+"="+value+"\n")
+}
+
+// WriteMultilineToGitHubOutput appends an output in the GitHub heredoc format
+// (see FormatHeredoc) to the file pointed to by the GITHUB_OUTPUT environment
+// variable. Unlike WriteToGitHubOutput, value may span multiple lines; a random
+// delimiter that does not occur as a line in value terminates the block.
+//
+// The name rules are the same as for WriteToGitHubOutput.
+//
+// Returns true on success, false on validation or I/O failure.
+func WriteMultilineToGitHubOutput(name, value string) bool {
+	githubOutput, ok := githubOutputPath()
+	if !ok {
+		return false
+	}
+
+	name, ok = normalizeOutputName(name)
+	if !ok {
 		return false
 	}
 
-	return appendOutputLine(githubOutput, name, value)
+	return reportWriteError(appendOutput(githubOutput, name, FormatHeredoc(name, value)))
 }
 
 // githubOutputPath returns the GITHUB_OUTPUT file path if it is available.
@@ -65,24 +110,37 @@ func isSingleLineValue(value string) bool {
 	return !strings.ContainsAny(value, "\r\n")
 }
 
-// appendOutputLine opens the GitHub output file in append mode
-// and writes one "name=value" line to it.
-func appendOutputLine(path, name, value string) bool {
+// reportWriteError converts a write error into the bool result of the non-error helpers.
+// I/O failures are logged; a missing GITHUB_OUTPUT and validation failures are not.
+func reportWriteError(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	if !errors.Is(err, ErrGitHubOutputUnset) &&
+		!errors.Is(err, ErrInvalidOutputName) &&
+		!errors.Is(err, ErrInvalidOutputValue) {
+		log.Printf("Failed to write GitHub output: %v", err)
+	}
+	return false
+}
+
+// appendOutput opens the GitHub output file in append mode
+// and writes the already formatted content for output name to it.
+func appendOutput(path, name, content string) (err error) {
 	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
 	if err != nil {
-		log.Printf("Failed to open GITHUB_OUTPUT file (%s): %v", path, err)
-		return false
+		return fmt.Errorf("open GITHUB_OUTPUT file (%s): %w", path, err)
 	}
 	defer func() {
-		if cerr := file.Close(); cerr != nil {
-			log.Printf("Failed to close GITHUB_OUTPUT file (%s): %v", path, cerr)
+		if cerr := file.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close GITHUB_OUTPUT file (%s): %w", path, cerr)
 		}
 	}()
 
-	if _, err := fmt.Fprintf(file, "%s=%s\n", name, value); err != nil {
-		log.Printf("Failed to write GitHub output %q: %v", name, err)
-		return false
+	if _, err := file.WriteString(content); err != nil {
+		return fmt.Errorf("write output %q: %w", name, err)
 	}
 
-	return true
+	return nil
 }
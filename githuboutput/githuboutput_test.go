@@ -1,8 +1,12 @@
 package githuboutput
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -276,3 +280,172 @@ func TestWriteToGitHubOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteMultilineToGitHubOutput(t *testing.T) {
+	newOutputFile := func(t *testing.T) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "github_output")
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		t.Setenv("GITHUB_OUTPUT", path)
+		return path
+	}
+
+	readFile := func(t *testing.T, path string) string {
+		t.Helper()
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile(%s): %v", path, err)
+		}
+		return string(b)
+	}
+
+	t.Run("writes heredoc block with fixed delimiter", func(t *testing.T) {
+		orig := newDelimiterCandidate
+		t.Cleanup(func() { newDelimiterCandidate = orig })
+		newDelimiterCandidate = func() string { return "DELIM" }
+
+		path := newOutputFile(t)
+		if !WriteToGitHubOutput("first", "1") {
+			t.Fatalf("single-line write failed")
+		}
+		if !WriteMultilineToGitHubOutput("  notes  ", "line one\nline two") {
+			t.Fatalf("WriteMultilineToGitHubOutput returned false")
+		}
+
+		want := "first=1\nnotes<<DELIM\nline one\nline two\nDELIM\n"
+		if got := readFile(t, path); got != want {
+			t.Fatalf("file content mismatch.\nwant:\n%q\ngot:\n%q", want, got)
+		}
+	})
+
+	t.Run("random delimiter is absent from value", func(t *testing.T) {
+		path := newOutputFile(t)
+		value := "a\r\nb\n\nc"
+		if !WriteMultilineToGitHubOutput("key", value) {
+			t.Fatalf("WriteMultilineToGitHubOutput returned false")
+		}
+
+		got := readFile(t, path)
+		header, rest, _ := strings.Cut(got, "\n")
+		delim, ok := strings.CutPrefix(header, "key<<")
+		if !ok || delim == "" {
+			t.Fatalf("unexpected header %q", header)
+		}
+		if strings.Contains(value, delim) {
+			t.Fatalf("delimiter %q occurs in value", delim)
+		}
+		if want := value + "\n" + delim + "\n"; rest != want {
+			t.Fatalf("body mismatch.\nwant:\n%q\ngot:\n%q", want, rest)
+		}
+	})
+
+	t.Run("GITHUB_OUTPUT not set", func(t *testing.T) {
+		t.Setenv("GITHUB_OUTPUT", "")
+		_ = os.Unsetenv("GITHUB_OUTPUT")
+
+		if WriteMultilineToGitHubOutput("key", "a\nb") {
+			t.Fatalf("expected false when GITHUB_OUTPUT is unset")
+		}
+	})
+
+	t.Run("GITHUB_OUTPUT unwritable", func(t *testing.T) {
+		t.Setenv("GITHUB_OUTPUT", filepath.Join(t.TempDir(), "missing-dir", "out.txt"))
+
+		if WriteMultilineToGitHubOutput("key", "a\nb") {
+			t.Fatalf("expected false for an invalid path")
+		}
+	})
+
+	for _, name := range []string{"", "  ", "bad=key", "bad\nkey"} {
+		t.Run("invalid name "+strconv.Quote(name), func(t *testing.T) {
+			path := newOutputFile(t)
+
+			if WriteMultilineToGitHubOutput(name, "a\nb") {
+				t.Fatalf("expected false for name %q", name)
+			}
+			if got := readFile(t, path); got != "" {
+				t.Fatalf("expected nothing written, got %q", got)
+			}
+		})
+	}
+}
+
+func TestWriteToGitHubOutputErr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "github_output")
+		if err := os.WriteFile(path, []byte("a=1\n"), 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		t.Setenv("GITHUB_OUTPUT", path)
+
+		if err := WriteToGitHubOutputErr(" key ", "value"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile(%s): %v", path, err)
+		}
+		if want := "a=1\nkey=value\n"; string(b) != want {
+			t.Fatalf("file content mismatch.\nwant:\n%q\ngot:\n%q", want, string(b))
+		}
+	})
+
+	t.Run("GITHUB_OUTPUT not set", func(t *testing.T) {
+		t.Setenv("GITHUB_OUTPUT", "")
+		_ = os.Unsetenv("GITHUB_OUTPUT")
+
+		if err := WriteToGitHubOutputErr("key", "value"); !errors.Is(err, ErrGitHubOutputUnset) {
+			t.Fatalf("expected ErrGitHubOutputUnset, got %v", err)
+		}
+	})
+
+	t.Run("missing directory wraps os error", func(t *testing.T) {
+		t.Setenv("GITHUB_OUTPUT", filepath.Join(t.TempDir(), "missing-dir", "out.txt"))
+
+		err := WriteToGitHubOutputErr("key", "value")
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("expected wrapped fs.ErrNotExist, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "missing-dir") {
+			t.Fatalf("error %q should name the path", err)
+		}
+	})
+
+	t.Run("directory path wraps os error", func(t *testing.T) {
+		t.Setenv("GITHUB_OUTPUT", t.TempDir())
+
+		err := WriteToGitHubOutputErr("key", "value")
+		var pathErr *fs.PathError
+		if !errors.As(err, &pathErr) {
+			t.Fatalf("expected wrapped *fs.PathError, got %v", err)
+		}
+	})
+
+	validation := []struct {
+		name    string
+		outName string
+		value   string
+		want    error
+	}{
+		{"empty name", "", "value", ErrInvalidOutputName},
+		{"name with equals", "bad=key", "value", ErrInvalidOutputName},
+		{"multiline value", "key", "a\nb", ErrInvalidOutputValue},
+		{"carriage return value", "key", "a\rb", ErrInvalidOutputValue},
+	}
+	for _, tt := range validation {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "github_output")
+			if err := os.WriteFile(path, nil, 0o600); err != nil {
+				t.Fatalf("WriteFile: %v", err)
+			}
+			t.Setenv("GITHUB_OUTPUT", path)
+
+			if err := WriteToGitHubOutputErr(tt.outName, tt.value); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package githuboutput
+
+import (
+	"crypto/rand"
+	"strings"
+)
+
+// delimiterPrefix marks generated heredoc delimiters so they are easy to spot in output files.
+const delimiterPrefix = "ghadelimiter_"
+
+// newDelimiterCandidate returns a random delimiter candidate.
+// It is a variable so tests can force collisions.
+var newDelimiterCandidate = func() string {
+	return delimiterPrefix + rand.Text()
+}
+
+// HeredocDelimiter returns a random delimiter that does not appear
+// as a standalone line within value, so it can safely terminate a
+// "name<<DELIM" block in the GITHUB_OUTPUT file.
+func HeredocDelimiter(value string) string {
+	for {
+		delim := newDelimiterCandidate()
+		if !containsLine(value, delim) {
+			return delim
+		}
+	}
+}
+
+// FormatHeredoc renders a multiline GitHub Actions output block:
+//
+//	name<<DELIM
+//	value
+//	DELIM
+//
+// The delimiter is chosen with HeredocDelimiter. The name is used as is;
+// callers are responsible for validating it.
+func FormatHeredoc(name, value string) string {
+	delim := HeredocDelimiter(value)
+
+	var b strings.Builder
+	b.Grow(len(name) + len(value) + 2*len(delim) + 4)
+	b.WriteString(name)
+	b.WriteString("<<")
+	b.WriteString(delim)
+	b.WriteByte('\n')
+	b.WriteString(value)
+	b.WriteByte('\n')
+	b.WriteString(delim)
+	b.WriteByte('\n')
+
+	return b.String()
+}
+
+// containsLine reports whether line appears as a whole line in s.
+// Lines may end with "\n" or "\r\n".
+func containsLine(s, line string) bool {
+	for l := range strings.SplitSeq(s, "\n") {
+		if strings.TrimSuffix(l, "\r") == line {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package githuboutput
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeredocDelimiter(t *testing.T) {
+	t.Run("random delimiter is not part of value", func(t *testing.T) {
+		value := "line1\nline2"
+
+		delim := HeredocDelimiter(value)
+		if !strings.HasPrefix(delim, delimiterPrefix) {
+			t.Fatalf("delimiter %q does not start with %q", delim, delimiterPrefix)
+		}
+		if containsLine(value, delim) {
+			t.Fatalf("delimiter %q collides with value", delim)
+		}
+	})
+
+	t.Run("colliding candidate is replaced", func(t *testing.T) {
+		candidates := []string{"EOF", "EOF", "FRESH"}
+		calls := 0
+		orig := newDelimiterCandidate
+		t.Cleanup(func() { newDelimiterCandidate = orig })
+		newDelimiterCandidate = func() string {
+			c := candidates[calls]
+			calls++
+			return c
+		}
+
+		got := HeredocDelimiter("first\nEOF\r\nlast")
+		if got != "FRESH" {
+			t.Fatalf("got %q, want %q", got, "FRESH")
+		}
+		if calls != 3 {
+			t.Fatalf("candidate generator called %d times, want 3", calls)
+		}
+	})
+
+	t.Run("delimiter as substring does not collide", func(t *testing.T) {
+		orig := newDelimiterCandidate
+		t.Cleanup(func() { newDelimiterCandidate = orig })
+		newDelimiterCandidate = func() string { return "EOF" }
+
+		if got := HeredocDelimiter("not EOF here\nEOFX"); got != "EOF" {
+			t.Fatalf("got %q, want %q", got, "EOF")
+		}
+	})
+}
+
+func TestFormatHeredoc(t *testing.T) {
+	orig := newDelimiterCandidate
+	t.Cleanup(func() { newDelimiterCandidate = orig })
+	newDelimiterCandidate = func() string { return "DELIM" }
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"multiline value", "a\nb", "key<<DELIM\na\nb\nDELIM\n"},
+		{"single line value", "a", "key<<DELIM\na\nDELIM\n"},
+		{"empty value", "", "key<<DELIM\n\nDELIM\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatHeredoc("key", tt.value); got != tt.want {
+				t.Fatalf("FormatHeredoc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TriState is a setting that can be forced on, forced off, or left to auto-detection.
+type TriState int
+
+const (
+	// TriStateAuto means the caller should detect the behavior itself.
+	TriStateAuto TriState = iota
+	// TriStateOn forces the behavior on.
+	TriStateOn
+	// TriStateOff forces the behavior off.
+	TriStateOff
+)
+
+// String returns the canonical token for the tri-state value.
+func (s TriState) String() string {
+	switch s {
+	case TriStateAuto:
+		return "auto"
+	case TriStateOn:
+		return "on"
+	case TriStateOff:
+		return "off"
+	default:
+		return fmt.Sprintf("TriState(%d)", int(s))
+	}
+}
+
+// ParseTriStateEnv parses an auto/on/off environment variable.
+// Matching is case-insensitive and surrounding whitespace is ignored:
+//   - "auto"                 => TriStateAuto
+//   - "on", "true", "yes"    => TriStateOn
+//   - "off", "false", "no"   => TriStateOff
+//
+// Returns TriStateAuto if the variable is not set or empty.
+// Returns an error for any other value.
+func ParseTriStateEnv(envVar string) (TriState, error) {
+	val := strings.ToLower(strings.TrimSpace(os.Getenv(envVar)))
+
+	switch val {
+	case "", "auto":
+		return TriStateAuto, nil
+	case "on", "true", "yes":
+		return TriStateOn, nil
+	case "off", "false", "no":
+		return TriStateOff, nil
+	default:
+		return TriStateAuto, fmt.Errorf("invalid value for %s: %q (expected auto, on, or off)", envVar, os.Getenv(envVar))
+	}
+}
+
+// acceptedBoolTokens lists the boolean tokens shown in user-facing errors.
+const acceptedBoolTokens = "true/false/1/0/yes/no/on/off"
+
+// ParseBoolEnvExplained parses a boolean environment variable like ParseBoolEnv,
+// but on failure returns a user-facing error naming the variable, the supplied value,
+// and the accepted tokens, e.g.:
+//
+//	invalid boolean for MYVAR: "maybe" (accepted: true/false/1/0/yes/no/on/off)
+func ParseBoolEnvExplained(envVar string) (bool, error) {
+	raw := os.Getenv(envVar)
+
+	val, err := ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean for %s: %q (accepted: %s)", envVar, strings.TrimSpace(raw), acceptedBoolTokens)
+	}
+	return val, nil
+}
+
+// ParseBoolEnvWithDefault parses a boolean environment variable like ParseBoolEnv,
+// but returns defaultVal instead of false if the variable is not set or empty.
+// Parse failures (e.g. a "treu" typo) are returned as errors rather than defaulted.
+func ParseBoolEnvWithDefault(envVar string, defaultVal bool) (bool, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	return ParseBool(val)
+}
+
+// ParseMutuallyExclusiveBools reads each key as a boolean (see ParseBoolEnv) and
+// returns the key that is set to true, if any.
+// Returns an empty key and no error if every key is unset or false.
+// Returns an error if any value cannot be parsed or more than one key is true.
+func ParseMutuallyExclusiveBools(keys ...string) (setKey string, err error) {
+	for _, key := range keys {
+		val, err := ParseBoolEnv(key)
+		if err != nil {
+			return "", fmt.Errorf("invalid boolean for %s: %w", key, err)
+		}
+		if !val {
+			continue
+		}
+		if setKey != "" {
+			return "", fmt.Errorf("%s and %s are mutually exclusive and cannot both be true", setKey, key)
+		}
+		setKey = key
+	}
+
+	return setKey, nil
+}
+
+// ParseDependentBoolEnv reads flagKey as a boolean (see ParseBoolEnv) that only applies
+// when requiredKey is true, e.g. CACHE_COMPRESS gated on CACHE.
+// Returns false and no error if requiredKey is unset or false; flagKey is not read then.
+// Returns an error if either value cannot be parsed.
+func ParseDependentBoolEnv(flagKey, requiredKey string) (bool, error) {
+	enabled, err := ParseBoolEnv(requiredKey)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean for %s: %w", requiredKey, err)
+	}
+	if !enabled {
+		return false, nil
+	}
+
+	val, err := ParseBoolEnv(flagKey)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean for %s: %w", flagKey, err)
+	}
+	return val, nil
+}
+
+// ParseBoolEnvDeprecating parses a boolean environment variable leniently
+// (case-insensitive, surrounding whitespace ignored) and reports whether the
+// supplied token is listed in deprecated (compared case-insensitively), e.g.
+// []string{"1", "0"} while migrating to true/false. Callers can use
+// usedDeprecated to emit a warning annotation.
+//
+// Returns false, false, nil if the variable is not set or empty.
+func ParseBoolEnvDeprecating(envVar string, deprecated []string) (value bool, usedDeprecated bool, err error) {
+	token := strings.TrimSpace(os.Getenv(envVar))
+	if token == "" {
+		return false, false, nil
+	}
+
+	value, err = parseBoolLenient(token)
+	if err != nil {
+		return false, false, fmt.Errorf("invalid boolean for %s: %w", envVar, err)
+	}
+
+	usedDeprecated = slices.ContainsFunc(deprecated, func(d string) bool {
+		return strings.EqualFold(strings.TrimSpace(d), token)
+	})
+
+	return value, usedDeprecated, nil
+}
+
+// ParseBoolWithDefaultToken parses a boolean environment variable that may also be
+// set to the literal token "default" (case-insensitive) to inherit defaultVal.
+// Other values are parsed leniently (case-insensitive, surrounding whitespace ignored).
+//
+// Returns defaultVal if the variable is not set, empty, or "default".
+// Returns an error for any other unrecognized value.
+func ParseBoolWithDefaultToken(envVar string, defaultVal bool) (bool, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" || strings.EqualFold(val, "default") {
+		return defaultVal, nil
+	}
+
+	b, err := parseBoolLenient(val)
+	if err != nil {
+		return defaultVal, fmt.Errorf("invalid boolean for %s: %w", envVar, err)
+	}
+	return b, nil
+}
+
+// parseBoolLenient parses a boolean token ignoring case and surrounding whitespace.
+// It accepts the strconv.ParseBool tokens plus y/n, yes/no and on/off, and reports
+// failures as a *strconv.NumError wrapping strconv.ErrSyntax, like strconv.ParseBool.
+func parseBoolLenient(raw string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "1", "t", "true", "y", "yes", "on":
+		return true, nil
+	case "0", "f", "false", "n", "no", "off":
+		return false, nil
+	default:
+		return false, &strconv.NumError{Func: "ParseBool", Num: raw, Err: strconv.ErrSyntax}
+	}
+}
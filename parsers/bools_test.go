@@ -0,0 +1,308 @@
+package parsers
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseTriStateEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected TriState
+	}{
+		{"Empty value", "", TriStateAuto},
+		{"Whitespace only", "   ", TriStateAuto},
+		{"auto", "auto", TriStateAuto},
+		{"AUTO uppercase", "AUTO", TriStateAuto},
+		{"on", "on", TriStateOn},
+		{"true", "true", TriStateOn},
+		{"yes", "yes", TriStateOn},
+		{"Mixed case and trimmed Yes", "  Yes \n", TriStateOn},
+		{"off", "off", TriStateOff},
+		{"false", "false", TriStateOff},
+		{"no", "no", TriStateOff},
+		{"Mixed case OFF", "OFF", TriStateOff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_TRISTATE", tt.envValue)
+
+			got, err := ParseTriStateEnv("TEST_TRISTATE")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("ParseTriStateEnv(%q) = %v, want %v", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("Unset variable", func(t *testing.T) {
+		t.Setenv("TEST_TRISTATE", "")
+		os.Unsetenv("TEST_TRISTATE")
+
+		got, err := ParseTriStateEnv("TEST_TRISTATE")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != TriStateAuto {
+			t.Fatalf("got %v, want %v", got, TriStateAuto)
+		}
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		t.Setenv("TEST_TRISTATE", "maybe")
+
+		_, err := ParseTriStateEnv("TEST_TRISTATE")
+		if err == nil || !strings.Contains(err.Error(), "TEST_TRISTATE") {
+			t.Fatalf("expected error naming TEST_TRISTATE, got %v", err)
+		}
+	})
+}
+
+func TestTriState_String(t *testing.T) {
+	tests := []struct {
+		in   TriState
+		want string
+	}{
+		{TriStateAuto, "auto"},
+		{TriStateOn, "on"},
+		{TriStateOff, "off"},
+		{TriState(42), "TriState(42)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Fatalf("TriState(%d).String() = %q, want %q", int(tt.in), got, tt.want)
+		}
+	}
+}
+
+func TestParseBoolEnvExplained(t *testing.T) {
+	t.Run("valid values", func(t *testing.T) {
+		for env, want := range map[string]bool{"": false, "yes": true, " off ": false, "TRUE": true} {
+			t.Setenv("TEST_MYVAR", env)
+
+			got, err := ParseBoolEnvExplained("TEST_MYVAR")
+			if err != nil {
+				t.Fatalf("ParseBoolEnvExplained(%q) unexpected error: %v", env, err)
+			}
+			if got != want {
+				t.Fatalf("ParseBoolEnvExplained(%q) = %v, want %v", env, got, want)
+			}
+		}
+	})
+
+	t.Run("invalid value explains accepted tokens", func(t *testing.T) {
+		t.Setenv("TEST_MYVAR", "  maybe ")
+
+		_, err := ParseBoolEnvExplained("TEST_MYVAR")
+		want := `invalid boolean for TEST_MYVAR: "maybe" (accepted: true/false/1/0/yes/no/on/off)`
+		if err == nil || err.Error() != want {
+			t.Fatalf("error = %v, want %q", err, want)
+		}
+	})
+}
+
+func TestParseBoolEnvWithDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		envValue   *string
+		defaultVal bool
+		expected   bool
+		wantErr    bool
+	}{
+		{"Unset uses true default", nil, true, true, false},
+		{"Unset uses false default", nil, false, false, false},
+		{"Empty uses default", new(""), true, true, false},
+		{"Whitespace uses default", new("  \t"), true, true, false},
+		{"Explicit false overrides true default", new("false"), true, false, false},
+		{"Explicit true overrides false default", new(" 1 "), false, true, false},
+		{"Typo is an error", new("treu"), true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CACHING", "")
+			os.Unsetenv("TEST_CACHING")
+			if tt.envValue != nil {
+				t.Setenv("TEST_CACHING", *tt.envValue)
+			}
+
+			got, err := ParseBoolEnvWithDefault("TEST_CACHING", tt.defaultVal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Fatalf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseMutuallyExclusiveBools(t *testing.T) {
+	keys := []string{"TEST_FORCE_HTTP", "TEST_FORCE_HTTPS"}
+
+	tests := []struct {
+		name    string
+		http    string
+		https   string
+		want    string
+		wantErr string
+	}{
+		{name: "none set", http: "", https: "", want: ""},
+		{name: "both false", http: "false", https: "0", want: ""},
+		{name: "exactly one set", http: "false", https: "true", want: "TEST_FORCE_HTTPS"},
+		{name: "first one set", http: " 1 ", https: "", want: "TEST_FORCE_HTTP"},
+		{name: "two set", http: "true", https: "true", wantErr: "mutually exclusive"},
+		{name: "invalid value", http: "maybe", https: "", wantErr: "invalid boolean for TEST_FORCE_HTTP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_FORCE_HTTP", tt.http)
+			t.Setenv("TEST_FORCE_HTTPS", tt.https)
+
+			got, err := ParseMutuallyExclusiveBools(keys...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDependentBoolEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		cache    string
+		compress string
+		want     bool
+		wantErr  string
+	}{
+		{name: "required unset ignores flag", cache: "", compress: "true", want: false},
+		{name: "required off ignores invalid flag", cache: "false", compress: "maybe", want: false},
+		{name: "required on, flag true", cache: "true", compress: "1", want: true},
+		{name: "required on, flag false", cache: "yes", compress: "false", want: false},
+		{name: "required on, flag unset", cache: "on", compress: "", want: false},
+		{name: "required on, invalid flag", cache: "true", compress: "maybe", wantErr: "invalid boolean for TEST_CACHE_COMPRESS"},
+		{name: "invalid required", cache: "sure", compress: "true", wantErr: "invalid boolean for TEST_CACHE:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CACHE", tt.cache)
+			t.Setenv("TEST_CACHE_COMPRESS", tt.compress)
+
+			got, err := ParseDependentBoolEnv("TEST_CACHE_COMPRESS", "TEST_CACHE")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBoolEnvDeprecating(t *testing.T) {
+	deprecated := []string{"1", "0"}
+
+	tests := []struct {
+		name           string
+		envValue       string
+		wantValue      bool
+		wantDeprecated bool
+		wantErr        bool
+	}{
+		{name: "deprecated true token", envValue: "1", wantValue: true, wantDeprecated: true},
+		{name: "deprecated false token", envValue: " 0 ", wantValue: false, wantDeprecated: true},
+		{name: "modern true token", envValue: "true", wantValue: true},
+		{name: "modern false token", envValue: "false", wantValue: false},
+		{name: "lenient casing", envValue: "TrUe", wantValue: true},
+		{name: "unset", envValue: ""},
+		{name: "invalid", envValue: "maybe", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENABLE", tt.envValue)
+
+			value, usedDeprecated, err := ParseBoolEnvDeprecating("TEST_ENABLE", deprecated)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if value != tt.wantValue {
+				t.Fatalf("value = %v, want %v", value, tt.wantValue)
+			}
+			if usedDeprecated != tt.wantDeprecated {
+				t.Fatalf("usedDeprecated = %v, want %v", usedDeprecated, tt.wantDeprecated)
+			}
+		})
+	}
+
+	t.Run("deprecated tokens match case-insensitively", func(t *testing.T) {
+		t.Setenv("TEST_ENABLE", "T")
+
+		value, usedDeprecated, err := ParseBoolEnvDeprecating("TEST_ENABLE", []string{"t", "f"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !value || !usedDeprecated {
+			t.Fatalf("got (%v, %v), want (true, true)", value, usedDeprecated)
+		}
+	})
+}
+
+func TestParseBoolWithDefaultToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		envValue   *string
+		defaultVal bool
+		want       bool
+		wantErr    bool
+	}{
+		{name: "default token inherits true", envValue: new("default"), defaultVal: true, want: true},
+		{name: "default token inherits false", envValue: new(" DEFAULT "), defaultVal: false, want: false},
+		{name: "true overrides", envValue: new("true"), defaultVal: false, want: true},
+		{name: "false overrides", envValue: new("False"), defaultVal: true, want: false},
+		{name: "unset", envValue: nil, defaultVal: true, want: true},
+		{name: "empty", envValue: new(""), defaultVal: true, want: true},
+		{name: "invalid", envValue: new("inherit"), defaultVal: true, want: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_OPTION", "")
+			os.Unsetenv("TEST_OPTION")
+			if tt.envValue != nil {
+				t.Setenv("TEST_OPTION", *tt.envValue)
+			}
+
+			got, err := ParseBoolWithDefaultToken("TEST_OPTION", tt.defaultVal)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr = %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSpec is a parsed standard 5-field cron expression
+// (minute hour day-of-month month day-of-week).
+type CronSpec struct {
+	expr   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// domRestricted/dowRestricted follow the classic cron rule: when both
+	// day fields are restricted, a time matches if either of them matches.
+	domRestricted bool
+	dowRestricted bool
+}
+
+// cronField describes the allowed range of a single cron field.
+type cronField struct {
+	name string
+	min  int
+	max  int
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	// 7 is accepted as an alias for Sunday.
+	{"day-of-week", 0, 7},
+}
+
+// ParseCronEnv parses a required standard 5-field cron expression.
+// Each field accepts "*", single values, ranges ("1-5"), steps ("*/15", "0-30/5", "10/20")
+// and comma-separated lists of those. Only numeric values are supported;
+// day-of-week uses 0-6 with 7 as an alias for Sunday.
+//
+// Returns an error if the variable is unset, has the wrong number of fields,
+// or any field contains an invalid token or out-of-range value.
+func ParseCronEnv(envVar string) (CronSpec, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return CronSpec{}, fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	fields := strings.Fields(val)
+	if len(fields) != len(cronFields) {
+		return CronSpec{}, fmt.Errorf(
+			"cron expression in %s must have 5 fields (minute hour day-of-month month day-of-week), got %d",
+			envVar, len(fields),
+		)
+	}
+
+	var sets [5]uint64
+	for i, f := range cronFields {
+		set, err := parseCronField(f, fields[i])
+		if err != nil {
+			return CronSpec{}, fmt.Errorf("invalid cron expression in %s: %w", envVar, err)
+		}
+		sets[i] = set
+	}
+
+	// Fold the Sunday alias (7) into 0.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] = sets[4]&^(1<<7) | 1
+	}
+
+	return CronSpec{
+		expr:          strings.Join(fields, " "),
+		minute:        sets[0],
+		hour:          sets[1],
+		dom:           sets[2],
+		month:         sets[3],
+		dow:           sets[4],
+		domRestricted: !strings.HasPrefix(fields[2], "*"),
+		dowRestricted: !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// String returns the normalized cron expression.
+func (c CronSpec) String() string {
+	return c.expr
+}
+
+// Matches reports whether t (in its own location, truncated to the minute) satisfies the spec.
+func (c CronSpec) Matches(t time.Time) bool {
+	if !hasBit(c.minute, t.Minute()) || !hasBit(c.hour, t.Hour()) || !hasBit(c.month, int(t.Month())) {
+		return false
+	}
+
+	domMatch := hasBit(c.dom, t.Day())
+	dowMatch := hasBit(c.dow, int(t.Weekday()))
+
+	if c.domRestricted && c.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+func hasBit(set uint64, n int) bool {
+	return set&(1<<uint(n)) != 0
+}
+
+// parseCronField parses one cron field into a bitset of allowed values.
+func parseCronField(f cronField, s string) (uint64, error) {
+	var set uint64
+
+	for item := range strings.SplitSeq(s, ",") {
+		if item == "" {
+			return 0, fmt.Errorf("invalid %s field %q: empty list item", f.name, s)
+		}
+
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid %s field %q: invalid step %q", f.name, s, stepPart)
+			}
+			step = n
+		}
+
+		start, end := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			lo, hi, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseCronValue(f, s, lo); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(f, s, hi); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid %s field %q: range start %d is after end %d", f.name, s, start, end)
+			}
+		default:
+			n, err := parseCronValue(f, s, rangePart)
+			if err != nil {
+				return 0, err
+			}
+			start = n
+			// "N/step" means "from N to the end of the range, every step".
+			if !hasStep {
+				end = n
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+func parseCronValue(f cronField, field, s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || strings.HasPrefix(s, "+") {
+		return 0, fmt.Errorf("invalid %s field %q: invalid value %q", f.name, field, s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid %s field %q: value %d out of range [%d-%d]", f.name, field, n, f.min, f.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,116 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCronEnv_Valid(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		matching []time.Time
+		missing  []time.Time
+	}{
+		{
+			name:     "every minute",
+			expr:     "* * * * *",
+			matching: []time.Time{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 7, 15, 13, 37, 0, 0, time.UTC)},
+		},
+		{
+			name:     "specific time",
+			expr:     "30 14 * * *",
+			matching: []time.Time{time.Date(2026, 3, 10, 14, 30, 0, 0, time.UTC)},
+			missing:  []time.Time{time.Date(2026, 3, 10, 14, 31, 0, 0, time.UTC), time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)},
+		},
+		{
+			name:     "steps, ranges and lists",
+			expr:     "*/15 9-17 * 1,6 *",
+			matching: []time.Time{time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC), time.Date(2026, 6, 5, 17, 45, 0, 0, time.UTC)},
+			missing:  []time.Time{time.Date(2026, 1, 5, 9, 10, 0, 0, time.UTC), time.Date(2026, 2, 5, 9, 0, 0, 0, time.UTC), time.Date(2026, 1, 5, 18, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "weekdays only",
+			expr: "0 8 * * 1-5",
+			// 2026-10-12 is a Monday, 2026-10-18 is a Sunday.
+			matching: []time.Time{time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC)},
+			missing:  []time.Time{time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "sunday alias 7",
+			expr:     "0 0 * * 7",
+			matching: []time.Time{time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+			missing:  []time.Time{time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "day-of-month or day-of-week when both restricted",
+			expr: "0 0 1 * 1",
+			// 2026-10-01 is a Thursday; 2026-10-12 is a Monday; 2026-10-13 is neither.
+			matching: []time.Time{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+			missing:  []time.Time{time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "start with step",
+			expr:     "10/20 * * * *",
+			matching: []time.Time{time.Date(2026, 1, 1, 0, 10, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 50, 0, 0, time.UTC)},
+			missing:  []time.Time{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 20, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_SCHEDULE", "  "+tt.expr+" ")
+
+			spec, err := ParseCronEnv("TEST_SCHEDULE")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if spec.String() != tt.expr {
+				t.Fatalf("String() = %q, want %q", spec.String(), tt.expr)
+			}
+			for _, ts := range tt.matching {
+				if !spec.Matches(ts) {
+					t.Fatalf("expected %q to match %v", tt.expr, ts)
+				}
+			}
+			for _, ts := range tt.missing {
+				if spec.Matches(ts) {
+					t.Fatalf("expected %q not to match %v", tt.expr, ts)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCronEnv_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "unset", expr: "", wantErr: "required"},
+		{name: "too few fields", expr: "* * * *", wantErr: "must have 5 fields"},
+		{name: "too many fields", expr: "* * * * * *", wantErr: "got 6"},
+		{name: "minute out of range", expr: "60 * * * *", wantErr: "minute field \"60\": value 60 out of range [0-59]"},
+		{name: "hour out of range", expr: "0 24 * * *", wantErr: "hour field"},
+		{name: "day-of-month zero", expr: "0 0 0 * *", wantErr: "day-of-month field"},
+		{name: "month out of range", expr: "0 0 * 13 *", wantErr: "month field"},
+		{name: "day-of-week out of range", expr: "0 0 * * 8", wantErr: "day-of-week field"},
+		{name: "invalid token", expr: "a * * * *", wantErr: "invalid value \"a\""},
+		{name: "invalid step", expr: "*/0 * * * *", wantErr: "invalid step"},
+		{name: "reversed range", expr: "0 10-5 * * *", wantErr: "range start 10 is after end 5"},
+		{name: "empty list item", expr: "1,,2 * * * *", wantErr: "empty list item"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_SCHEDULE", tt.expr)
+
+			_, err := ParseCronEnv("TEST_SCHEDULE")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
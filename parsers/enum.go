@@ -0,0 +1,45 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ParseEnumEnv reads an environment variable that must be one of allowed
+// (e.g. "sync", "push", "pull"). Matching is case-sensitive; see ParseEnumEnvFold
+// for case-insensitive matching.
+// Returns defaultVal if the variable is not set or empty.
+// Returns an error listing the allowed values if the input does not match.
+func ParseEnumEnv(envVar string, allowed []string, defaultVal string) (string, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	if slices.Contains(allowed, val) {
+		return val, nil
+	}
+	return "", enumError(envVar, val, allowed)
+}
+
+// ParseEnumEnvFold works like ParseEnumEnv but matches case-insensitively
+// and returns the canonical spelling from allowed ("PUSH" => "push").
+func ParseEnumEnvFold(envVar string, allowed []string, defaultVal string) (string, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	for _, a := range allowed {
+		if strings.EqualFold(a, val) {
+			return a, nil
+		}
+	}
+	return "", enumError(envVar, val, allowed)
+}
+
+func enumError(envVar, val string, allowed []string) error {
+	return fmt.Errorf("invalid value for %s: %q (allowed: %s)", envVar, val, strings.Join(allowed, ", "))
+}
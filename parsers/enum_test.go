@@ -0,0 +1,80 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseEnumEnv(t *testing.T) {
+	allowed := []string{"sync", "push", "pull"}
+
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+		wantErr  string
+	}{
+		{name: "allowed value", envValue: "push", want: "push"},
+		{name: "trimmed value", envValue: "  pull \n", want: "pull"},
+		{name: "empty uses default", envValue: "", want: "sync"},
+		{name: "case mismatch is rejected", envValue: "PUSH", wantErr: `invalid value for TEST_MODE: "PUSH" (allowed: sync, push, pull)`},
+		{name: "unknown value", envValue: "merge", wantErr: "allowed: sync, push, pull"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_MODE", tt.envValue)
+
+			got, err := ParseEnumEnv("TEST_MODE", allowed, "sync")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEnumEnvFold(t *testing.T) {
+	allowed := []string{"sync", "Push", "pull"}
+
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+		wantErr  string
+	}{
+		{name: "exact value", envValue: "sync", want: "sync"},
+		{name: "returns canonical spelling", envValue: "PUSH", want: "Push"},
+		{name: "mixed case", envValue: " PuLl ", want: "pull"},
+		{name: "empty uses default", envValue: "", want: "sync"},
+		{name: "unknown value", envValue: "merge", wantErr: "allowed: sync, Push, pull"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_MODE", tt.envValue)
+
+			got, err := ParseEnumEnvFold("TEST_MODE", allowed, "sync")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package parsers
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+)
+
+// ApplyEnvJSON reads a JSON object of {"VAR": "value"} pairs from path
+// and sets each pair as an environment variable.
+// Variables that are already set (even to an empty value) are left untouched
+// unless overwrite is true. Keys are applied in sorted order.
+//
+// Returns an error if the file cannot be read, is not a JSON object of strings,
+// or a variable cannot be set.
+func ApplyEnvJSON(path string, overwrite bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read env file %s: %w", path, err)
+	}
+
+	var vars map[string]string
+	if err := json.Unmarshal(data, &vars); err != nil {
+		return fmt.Errorf("parse env file %s: %w", path, err)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(vars)) {
+		if _, set := os.LookupEnv(key); set && !overwrite {
+			continue
+		}
+		if err := os.Setenv(key, vars[key]); err != nil {
+			return fmt.Errorf("set %s from env file %s: %w", key, path, err)
+		}
+	}
+
+	return nil
+}
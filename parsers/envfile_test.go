@@ -0,0 +1,92 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeEnvJSON(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "env.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestApplyEnvJSON(t *testing.T) {
+	const content = `{"TEST_APPLY_NEW": "new", "TEST_APPLY_PRESET": "from-file"}`
+
+	t.Run("sets unset vars and keeps pre-set ones", func(t *testing.T) {
+		path := writeEnvJSON(t, content)
+		t.Setenv("TEST_APPLY_NEW", "")
+		os.Unsetenv("TEST_APPLY_NEW")
+		t.Setenv("TEST_APPLY_PRESET", "original")
+
+		if err := ApplyEnvJSON(path, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := os.Getenv("TEST_APPLY_NEW"); got != "new" {
+			t.Fatalf("TEST_APPLY_NEW = %q, want %q", got, "new")
+		}
+		if got := os.Getenv("TEST_APPLY_PRESET"); got != "original" {
+			t.Fatalf("TEST_APPLY_PRESET = %q, want %q", got, "original")
+		}
+	})
+
+	t.Run("overwrite replaces pre-set vars", func(t *testing.T) {
+		path := writeEnvJSON(t, content)
+		t.Setenv("TEST_APPLY_NEW", "")
+		os.Unsetenv("TEST_APPLY_NEW")
+		t.Setenv("TEST_APPLY_PRESET", "original")
+
+		if err := ApplyEnvJSON(path, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := os.Getenv("TEST_APPLY_NEW"); got != "new" {
+			t.Fatalf("TEST_APPLY_NEW = %q, want %q", got, "new")
+		}
+		if got := os.Getenv("TEST_APPLY_PRESET"); got != "from-file" {
+			t.Fatalf("TEST_APPLY_PRESET = %q, want %q", got, "from-file")
+		}
+	})
+
+	t.Run("empty pre-set value counts as set", func(t *testing.T) {
+		path := writeEnvJSON(t, content)
+		t.Setenv("TEST_APPLY_PRESET", "")
+
+		if err := ApplyEnvJSON(path, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, ok := os.LookupEnv("TEST_APPLY_PRESET"); !ok || got != "" {
+			t.Fatalf("TEST_APPLY_PRESET = (%q, %v), want (\"\", true)", got, ok)
+		}
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		path := writeEnvJSON(t, `{"TEST_APPLY_NEW": `)
+
+		err := ApplyEnvJSON(path, false)
+		if err == nil || !strings.Contains(err.Error(), "parse env file") {
+			t.Fatalf("expected parse error, got %v", err)
+		}
+	})
+
+	t.Run("non-string values are rejected", func(t *testing.T) {
+		path := writeEnvJSON(t, `{"TEST_APPLY_NEW": 1}`)
+
+		if err := ApplyEnvJSON(path, false); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := ApplyEnvJSON(filepath.Join(t.TempDir(), "missing.json"), false)
+		if err == nil || !strings.Contains(err.Error(), "read env file") {
+			t.Fatalf("expected read error, got %v", err)
+		}
+	})
+}
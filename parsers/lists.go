@@ -0,0 +1,339 @@
+package parsers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultTabWidth is the indentation width of a tab used by ParseIndentedListEnv.
+const DefaultTabWidth = 4
+
+// IndentedEntry is a list entry together with its leading indentation depth.
+type IndentedEntry struct {
+	Indent int
+	Value  string
+}
+
+// ParseIndentedListEnv is ParseIndentedListEnvTabWidth with DefaultTabWidth.
+func ParseIndentedListEnv(envVar string) []IndentedEntry {
+	return ParseIndentedListEnvTabWidth(envVar, DefaultTabWidth)
+}
+
+// ParseIndentedListEnvTabWidth parses a multiline environment variable and records
+// the leading indentation of each line before trimming it. A space counts as 1,
+// a tab counts as tabWidth (values below 1 fall back to DefaultTabWidth).
+// Line endings are normalized like in ParseStringArrayEnv; blank lines are skipped.
+//
+// Example (tabWidth 4):
+//
+//	input:  "root\n  child\n\tchild2"
+//	output: [{0 "root"} {2 "child"} {4 "child2"}]
+func ParseIndentedListEnvTabWidth(envVar string, tabWidth int) []IndentedEntry {
+	if tabWidth < 1 {
+		tabWidth = DefaultTabWidth
+	}
+
+	val := os.Getenv(envVar)
+	if val == "" {
+		return []IndentedEntry{}
+	}
+
+	lines := splitLines(val)
+	out := make([]IndentedEntry, 0, len(lines))
+
+	for _, line := range lines {
+		value := strings.TrimSpace(line)
+		if value == "" {
+			continue
+		}
+
+		indent := 0
+	indentLoop:
+		for _, r := range line {
+			switch r {
+			case ' ':
+				indent++
+			case '\t':
+				indent += tabWidth
+			default:
+				break indentLoop
+			}
+		}
+
+		out = append(out, IndentedEntry{Indent: indent, Value: value})
+	}
+
+	return out
+}
+
+// LineOpts configures ParseLinesEnv. The zero value splits the variable into
+// lines and keeps every line as is.
+type LineOpts struct {
+	// Trim removes leading and trailing whitespace from each line.
+	Trim bool
+	// DropBlank skips lines that are empty or whitespace-only.
+	DropBlank bool
+	// Dedup keeps only the first occurrence of each line (compared after Trim and Lower).
+	Dedup bool
+	// Lower lowercases each line.
+	Lower bool
+	// CommentPrefix, if non-empty, skips lines starting with it
+	// (leading whitespace is ignored for this check), e.g. "#".
+	CommentPrefix string
+	// Validate, if set, is called on each retained line; the first failure is returned.
+	Validate func(string) error
+	// Max, if positive, is the maximum number of retained lines.
+	Max int
+}
+
+// ParseLinesEnv parses a multiline environment variable according to opts.
+// A leading UTF-8 BOM is stripped and line endings are normalized like in
+// ParseStringArrayEnv, which is equivalent to LineOpts{Trim: true, DropBlank: true}.
+// Steps are applied in order: comment skipping, Trim, DropBlank, Lower, Dedup,
+// Validate, Max.
+//
+// Returns an empty slice if the variable is not set or empty.
+// Returns an error naming the line and its index in the retained lines if Validate fails,
+// or if more than Max lines are retained.
+func ParseLinesEnv(envVar string, opts LineOpts) ([]string, error) {
+	val := os.Getenv(envVar)
+	if val == "" {
+		return []string{}, nil
+	}
+
+	lines := splitLines(val)
+	out := make([]string, 0, len(lines))
+
+	var seen map[string]struct{}
+	if opts.Dedup {
+		seen = make(map[string]struct{}, len(lines))
+	}
+
+	for _, line := range lines {
+		if opts.CommentPrefix != "" && strings.HasPrefix(strings.TrimSpace(line), opts.CommentPrefix) {
+			continue
+		}
+		if opts.Trim {
+			line = strings.TrimSpace(line)
+		}
+		if opts.DropBlank && strings.TrimSpace(line) == "" {
+			continue
+		}
+		if opts.Lower {
+			line = strings.ToLower(line)
+		}
+		if opts.Dedup {
+			if _, dup := seen[line]; dup {
+				continue
+			}
+			seen[line] = struct{}{}
+		}
+		if opts.Validate != nil {
+			if err := opts.Validate(line); err != nil {
+				return nil, fmt.Errorf("invalid entry %q at index %d in %s: %w", line, len(out), envVar, err)
+			}
+		}
+		if opts.Max > 0 && len(out) >= opts.Max {
+			return nil, fmt.Errorf("too many entries in %s: more than %d", envVar, opts.Max)
+		}
+
+		out = append(out, line)
+	}
+
+	return out, nil
+}
+
+// ParseSliceEnv reads an env var as multiline list (using ParseStringArrayEnv)
+// and converts each entry with conv, preserving order.
+// Returns an empty slice if the env var is unset or empty.
+// Returns an error naming the first entry that conv fails on.
+//
+// Example:
+//
+//	ports, err := ParseSliceEnv("PORTS", strconv.Atoi)
+func ParseSliceEnv[T any](envVar string, conv func(string) (T, error)) ([]T, error) {
+	lines := ParseStringArrayEnv(envVar)
+	out := make([]T, 0, len(lines))
+
+	for _, line := range lines {
+		v, err := conv(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q in %s: %w", line, envVar, err)
+		}
+		out = append(out, v)
+	}
+
+	return out, nil
+}
+
+// ParseCSVEnv parses an environment variable as a single CSV record using encoding/csv,
+// so quoted fields may contain separators: `"a,b", c` => []string{"a,b", "c"}.
+// Leading spaces before each field are ignored.
+// Returns an empty slice if the variable is not set or empty.
+// Returns an error for malformed CSV or if the value holds more than one record.
+func ParseCSVEnv(envVar string) ([]string, error) {
+	val := strings.TrimSpace(strings.TrimPrefix(os.Getenv(envVar), utf8BOM))
+	if val == "" {
+		return []string{}, nil
+	}
+
+	r := csv.NewReader(strings.NewReader(val))
+	r.TrimLeadingSpace = true
+
+	fields, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV in %s: %w", envVar, err)
+	}
+
+	if _, err := r.Read(); !errors.Is(err, io.EOF) {
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV in %s: %w", envVar, err)
+		}
+		return nil, fmt.Errorf("invalid CSV in %s: expected a single record", envVar)
+	}
+
+	return fields, nil
+}
+
+// ParseInClauseEnv parses a comma-separated environment variable (using ParseStringArrayEnvSep)
+// into a parenthesized SQL placeholder list and the matching query arguments, e.g.
+// "a, b,c" => "(?,?,?)", []any{"a", "b", "c"}. Values are never interpolated into the
+// placeholder string, so it is safe to splice into a query.
+// Returns an error if the variable is not set or holds no values, since "IN ()" is invalid SQL.
+func ParseInClauseEnv(envVar string) (placeholders string, args []any, err error) {
+	values := ParseStringArrayEnvSep(envVar, ",")
+	if len(values) == 0 {
+		return "", nil, fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	args = make([]any, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+
+	return "(" + strings.Repeat("?,", len(values)-1) + "?)", args, nil
+}
+
+// ParseMapEnv parses newline-separated "key=value" pairs into a map.
+// Each line is split on the first "=", and both key and value are trimmed,
+// so values may themselves contain "=". Blank lines are skipped.
+// Duplicate keys are allowed: the last occurrence wins.
+// Returns an empty map if the variable is not set or empty.
+// Returns an error naming the 1-based line number for lines without "=" or with an empty key.
+func ParseMapEnv(envVar string) (map[string]string, error) {
+	out := make(map[string]string)
+
+	val := os.Getenv(envVar)
+	if val == "" {
+		return out, nil
+	}
+
+	for i, line := range splitLines(val) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry in %s at line %d: %q (expected key=value)", envVar, i+1, line)
+		}
+
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("invalid entry in %s at line %d: %q (empty key)", envVar, i+1, line)
+		}
+
+		out[key] = strings.TrimSpace(value)
+	}
+
+	return out, nil
+}
+
+// ParseWeightsSumEnv parses "name:weight" pairs separated by commas or newlines
+// (e.g. "a:2,b:3") into a map. Names and weights are trimmed; empty items are skipped.
+// If wantSum is not -1, the weights must add up to exactly wantSum (e.g. 100 for percentages).
+// No weights at all (unset, empty, or only separators like ",,,") count as a sum of 0,
+// so they yield an empty map when wantSum is -1 or 0 and a sum mismatch error otherwise.
+// Returns an error for malformed pairs, duplicate names, negative weights, or a sum mismatch.
+func ParseWeightsSumEnv(envVar string, wantSum int) (map[string]int, error) {
+	out := make(map[string]int)
+
+	val := strings.TrimSpace(os.Getenv(envVar))
+	items := strings.FieldsFunc(val, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	})
+
+	sum := 0
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, weightStr, ok := strings.Cut(item, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid weight %q in %s (expected name:weight)", item, envVar)
+		}
+
+		weight, err := strconv.Atoi(strings.TrimSpace(weightStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %q in %s: not an integer", item, envVar)
+		}
+		if weight < 0 {
+			return nil, fmt.Errorf("invalid weight %q in %s: must not be negative", item, envVar)
+		}
+		if _, dup := out[name]; dup {
+			return nil, fmt.Errorf("duplicate weight name %q in %s", name, envVar)
+		}
+
+		out[name] = weight
+		sum += weight
+	}
+
+	if wantSum != -1 && sum != wantSum {
+		return nil, fmt.Errorf("weights in %s sum to %d, want %d", envVar, sum, wantSum)
+	}
+
+	return out, nil
+}
+
+// ParseFlagsEnv parses a comma-separated list of flag names (e.g. "audit,trace,cache")
+// and ORs together the bits configured for each name in names.
+// Names are trimmed and matched case-insensitively; empty items are skipped.
+//
+// Returns 0 if the variable is not set or empty.
+// Returns an error naming the first unknown flag.
+func ParseFlagsEnv(envVar string, names map[string]uint) (uint, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return 0, nil
+	}
+
+	bits := make(map[string]uint, len(names))
+	for name, bit := range names {
+		bits[strings.ToLower(strings.TrimSpace(name))] = bit
+	}
+
+	var flags uint
+	for item := range strings.SplitSeq(val, ",") {
+		name := strings.ToLower(strings.TrimSpace(item))
+		if name == "" {
+			continue
+		}
+
+		bit, ok := bits[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown flag %q in %s", strings.TrimSpace(item), envVar)
+		}
+		flags |= bit
+	}
+
+	return flags, nil
+}
@@ -0,0 +1,401 @@
+package parsers
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseIndentedListEnv(t *testing.T) {
+	t.Run("mixed spaces and tabs with default width", func(t *testing.T) {
+		t.Setenv("TEST_TREE", "root\n  child\r\n\tchild2\n\t  deep  \n\n   \nnext")
+
+		got := ParseIndentedListEnv("TEST_TREE")
+		want := []IndentedEntry{
+			{Indent: 0, Value: "root"},
+			{Indent: 2, Value: "child"},
+			{Indent: 4, Value: "child2"},
+			{Indent: 6, Value: "deep"},
+			{Indent: 0, Value: "next"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("custom tab width", func(t *testing.T) {
+		t.Setenv("TEST_TREE", "a\n\tb\n\t\tc\n \td")
+
+		got := ParseIndentedListEnvTabWidth("TEST_TREE", 2)
+		want := []IndentedEntry{
+			{Indent: 0, Value: "a"},
+			{Indent: 2, Value: "b"},
+			{Indent: 4, Value: "c"},
+			{Indent: 3, Value: "d"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("non-positive tab width falls back to default", func(t *testing.T) {
+		t.Setenv("TEST_TREE", "\tx")
+
+		got := ParseIndentedListEnvTabWidth("TEST_TREE", 0)
+		want := []IndentedEntry{{Indent: DefaultTabWidth, Value: "x"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unset returns empty slice", func(t *testing.T) {
+		t.Setenv("TEST_TREE", "")
+
+		got := ParseIndentedListEnv("TEST_TREE")
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %v, want empty slice", got)
+		}
+	})
+}
+
+func TestParseLinesEnv(t *testing.T) {
+	const input = "  Alpha \n\n# comment\n   \nbeta\r\nALPHA\n  # indented comment\nbeta"
+
+	tests := []struct {
+		name     string
+		opts     LineOpts
+		expected []string
+	}{
+		{"Zero options keep every line", LineOpts{}, []string{"  Alpha ", "", "# comment", "   ", "beta", "ALPHA", "  # indented comment", "beta"}},
+		{"Trim", LineOpts{Trim: true}, []string{"Alpha", "", "# comment", "", "beta", "ALPHA", "# indented comment", "beta"}},
+		{"DropBlank", LineOpts{DropBlank: true}, []string{"  Alpha ", "# comment", "beta", "ALPHA", "  # indented comment", "beta"}},
+		{"Dedup", LineOpts{Dedup: true}, []string{"  Alpha ", "", "# comment", "   ", "beta", "ALPHA", "  # indented comment"}},
+		{"Lower", LineOpts{Lower: true}, []string{"  alpha ", "", "# comment", "   ", "beta", "alpha", "  # indented comment", "beta"}},
+		{"CommentPrefix", LineOpts{CommentPrefix: "#"}, []string{"  Alpha ", "", "   ", "beta", "ALPHA", "beta"}},
+		{"All combined", LineOpts{Trim: true, DropBlank: true, Dedup: true, Lower: true, CommentPrefix: "#"}, []string{"alpha", "beta"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_LINES", input)
+
+			got, err := ParseLinesEnv("TEST_LINES", tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("unset returns empty slice", func(t *testing.T) {
+		t.Setenv("TEST_LINES", "")
+
+		got, err := ParseLinesEnv("TEST_LINES", LineOpts{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %q, want empty slice", got)
+		}
+	})
+
+	t.Run("Validate failure", func(t *testing.T) {
+		t.Setenv("TEST_LINES", "1\n\nx\n3")
+
+		_, err := ParseLinesEnv("TEST_LINES", LineOpts{
+			Trim:      true,
+			DropBlank: true,
+			Validate: func(s string) error {
+				_, err := strconv.Atoi(s)
+				return err
+			},
+		})
+		if err == nil || !strings.Contains(err.Error(), `invalid entry "x" at index 1 in TEST_LINES`) {
+			t.Fatalf("expected error naming the entry, got %v", err)
+		}
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		t.Setenv("TEST_LINES", "a\nb\na\nc")
+
+		got, err := ParseLinesEnv("TEST_LINES", LineOpts{Dedup: true, Max: 3})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+
+		_, err = ParseLinesEnv("TEST_LINES", LineOpts{Max: 3})
+		if err == nil || !strings.Contains(err.Error(), "too many entries in TEST_LINES") {
+			t.Fatalf("expected max error, got %v", err)
+		}
+	})
+}
+
+func TestParseSliceEnv(t *testing.T) {
+	t.Run("int converter", func(t *testing.T) {
+		t.Setenv("TEST_SLICE", "1\n 22 \r\n\n-3")
+
+		got, err := ParseSliceEnv("TEST_SLICE", strconv.Atoi)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []int{1, 22, -3}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("duration converter", func(t *testing.T) {
+		t.Setenv("TEST_SLICE", "1s\n5m")
+
+		got, err := ParseSliceEnv("TEST_SLICE", time.ParseDuration)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []time.Duration{time.Second, 5 * time.Minute}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("failing entry", func(t *testing.T) {
+		t.Setenv("TEST_SLICE", "1\ntwo\n3")
+
+		_, err := ParseSliceEnv("TEST_SLICE", strconv.Atoi)
+		if err == nil || !strings.Contains(err.Error(), `invalid value "two" in TEST_SLICE`) {
+			t.Fatalf("expected error naming the entry, got %v", err)
+		}
+	})
+
+	t.Run("unset returns empty slice", func(t *testing.T) {
+		t.Setenv("TEST_SLICE", "")
+
+		got, err := ParseSliceEnv("TEST_SLICE", strconv.Atoi)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %v, want empty slice", got)
+		}
+	})
+}
+
+func TestParseCSVEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     []string
+		wantErr  string
+	}{
+		{name: "plain fields", envValue: "a,b,c", want: []string{"a", "b", "c"}},
+		{name: "quoted field with comma", envValue: `"a,b","c"`, want: []string{"a,b", "c"}},
+		{name: "leading spaces ignored", envValue: `a, "b,c", d`, want: []string{"a", "b,c", "d"}},
+		{name: "escaped quote", envValue: `"say ""hi""",x`, want: []string{`say "hi"`, "x"}},
+		{name: "empty fields kept", envValue: "a,,b", want: []string{"a", "", "b"}},
+		{name: "quoted newline stays in one record", envValue: "\"line1\nline2\",x", want: []string{"line1\nline2", "x"}},
+		{name: "unset", envValue: "", want: []string{}},
+		{name: "bare quote in field", envValue: `a"b,c`, wantErr: "invalid CSV in TEST_CSV"},
+		{name: "unterminated quote", envValue: `"a,b`, wantErr: "invalid CSV"},
+		{name: "multiple records", envValue: "a,b\nc,d", wantErr: "expected a single record"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CSV", tt.envValue)
+
+			got, err := ParseCSVEnv("TEST_CSV")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInClauseEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		wantPH   string
+		wantArgs []any
+	}{
+		{"Single value", "en", "(?)", []any{"en"}},
+		{"Multiple values", " en, fr ,,de ", "(?,?,?)", []any{"en", "fr", "de"}},
+		{"Values with quotes stay in args", "it's", "(?)", []any{"it's"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_IN", tt.envValue)
+
+			ph, args, err := ParseInClauseEnv("TEST_IN")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ph != tt.wantPH {
+				t.Fatalf("placeholders = %q, want %q", ph, tt.wantPH)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Fatalf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+
+	for _, val := range []string{"", " , ,"} {
+		t.Run("empty list "+strconv.Quote(val), func(t *testing.T) {
+			t.Setenv("TEST_IN", val)
+
+			_, _, err := ParseInClauseEnv("TEST_IN")
+			if err == nil || !strings.Contains(err.Error(), "TEST_IN is required") {
+				t.Fatalf("expected required error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParseMapEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     map[string]string
+		wantErr  string
+	}{
+		{name: "simple pairs", envValue: "a=1\nb=2", want: map[string]string{"a": "1", "b": "2"}},
+		{name: "trims key and value", envValue: "  a =  one \r\n b= two", want: map[string]string{"a": "one", "b": "two"}},
+		{name: "splits on first equals", envValue: "query=x=y", want: map[string]string{"query": "x=y"}},
+		{name: "empty value allowed", envValue: "a=", want: map[string]string{"a": ""}},
+		{name: "blank lines skipped", envValue: "\na=1\n   \n\nb=2\n", want: map[string]string{"a": "1", "b": "2"}},
+		{name: "last duplicate wins", envValue: "a=1\nb=2\na=3", want: map[string]string{"a": "3", "b": "2"}},
+		{name: "unset", envValue: "", want: map[string]string{}},
+		{name: "missing equals names line", envValue: "a=1\n\nbroken", wantErr: "at line 3"},
+		{name: "empty key", envValue: " =value", wantErr: "empty key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_LABELS", tt.envValue)
+
+			got, err := ParseMapEnv("TEST_LABELS")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWeightsSumEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		wantSum  int
+		want     map[string]int
+		wantErr  string
+	}{
+		{name: "correct sum", envValue: "a:60, b:40", wantSum: 100, want: map[string]int{"a": 60, "b": 40}},
+		{name: "newline separated", envValue: "a: 2\nb :3\n", wantSum: 5, want: map[string]int{"a": 2, "b": 3}},
+		{name: "zero weight allowed", envValue: "a:0,b:10", wantSum: 10, want: map[string]int{"a": 0, "b": 10}},
+		{name: "no check", envValue: "a:2,b:3", wantSum: -1, want: map[string]int{"a": 2, "b": 3}},
+		{name: "unset without check", envValue: "", wantSum: -1, want: map[string]int{}},
+		{name: "unset with zero sum", envValue: "", wantSum: 0, want: map[string]int{}},
+		{name: "unset with sum check", envValue: "", wantSum: 100, wantErr: "sum to 0, want 100"},
+		{name: "only separators without check", envValue: ",,,", wantSum: -1, want: map[string]int{}},
+		{name: "only separators with sum check", envValue: ",,,", wantSum: 100, wantErr: "sum to 0, want 100"},
+		{name: "incorrect sum", envValue: "a:2,b:3", wantSum: 100, wantErr: "sum to 5, want 100"},
+		{name: "duplicate name", envValue: "a:2,a:3", wantSum: -1, wantErr: `duplicate weight name "a"`},
+		{name: "negative weight", envValue: "a:-1,b:101", wantSum: 100, wantErr: "must not be negative"},
+		{name: "missing colon", envValue: "a2", wantSum: -1, wantErr: "expected name:weight"},
+		{name: "empty name", envValue: ":2", wantSum: -1, wantErr: "expected name:weight"},
+		{name: "non-integer weight", envValue: "a:two", wantSum: -1, wantErr: "not an integer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_WEIGHTS", tt.envValue)
+
+			got, err := ParseWeightsSumEnv("TEST_WEIGHTS", tt.wantSum)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFlagsEnv(t *testing.T) {
+	const (
+		flagAudit uint = 1 << iota
+		flagTrace
+		flagCache
+	)
+	names := map[string]uint{
+		"audit": flagAudit,
+		"Trace": flagTrace,
+		"cache": flagCache,
+	}
+
+	tests := []struct {
+		name     string
+		envValue string
+		want     uint
+		wantErr  string
+	}{
+		{name: "empty input", envValue: "", want: 0},
+		{name: "single flag", envValue: "audit", want: flagAudit},
+		{name: "several flags", envValue: "audit,trace,cache", want: flagAudit | flagTrace | flagCache},
+		{name: "case-insensitive and trimmed", envValue: " AUDIT , trace ", want: flagAudit | flagTrace},
+		{name: "empty items skipped", envValue: "cache,,", want: flagCache},
+		{name: "repeated flag", envValue: "cache,cache", want: flagCache},
+		{name: "unknown flag", envValue: "audit,debug", wantErr: `unknown flag "debug"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_FEATURES", tt.envValue)
+
+			got, err := ParseFlagsEnv("TEST_FEATURES", names)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %b, want %b", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package parsers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseLocaleListEnv reads an env var as multiline list (using ParseStringArrayEnv)
+// and normalizes each entry to a canonical language(-region) code:
+// lowercase language, uppercase region, hyphen separator ("en_us", "EN-US" => "en-US").
+// The language must be 2-3 ASCII letters; the optional region must be
+// 2 ASCII letters or 3 digits (e.g. "es-419"). Duplicates are removed (order-preserving).
+// Returns an empty slice if the env var is unset or empty.
+// Returns an error naming the first entry that is not a valid code.
+func ParseLocaleListEnv(envVar string) ([]string, error) {
+	raw := ParseStringArrayEnv(envVar)
+
+	seen := make(map[string]struct{}, len(raw))
+	out := make([]string, 0, len(raw))
+
+	for _, entry := range raw {
+		locale, err := normalizeLocale(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid locale %q in %s: %w", entry, envVar, err)
+		}
+		if _, dup := seen[locale]; dup {
+			continue
+		}
+		seen[locale] = struct{}{}
+		out = append(out, locale)
+	}
+
+	return out, nil
+}
+
+func normalizeLocale(s string) (string, error) {
+	lang, region, hasRegion := strings.Cut(strings.ReplaceAll(s, "_", "-"), "-")
+
+	if len(lang) < 2 || len(lang) > 3 || !isASCIILetters(lang) {
+		return "", fmt.Errorf("language must be 2-3 letters")
+	}
+	lang = strings.ToLower(lang)
+
+	if !hasRegion {
+		return lang, nil
+	}
+
+	switch {
+	case len(region) == 2 && isASCIILetters(region):
+		return lang + "-" + strings.ToUpper(region), nil
+	case len(region) == 3 && isASCIIDigits(region):
+		return lang + "-" + region, nil
+	default:
+		return "", fmt.Errorf("region must be 2 letters or 3 digits")
+	}
+}
+
+func isASCIILetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseLocaleListEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     []string
+		wantErr  string
+	}{
+		{name: "underscore vs hyphen", envValue: "en_US\nfr-FR", want: []string{"en-US", "fr-FR"}},
+		{name: "casing variations collapse", envValue: "en-us\nEN-US\nen_US\nEn_uS", want: []string{"en-US"}},
+		{name: "language only", envValue: "DE\nfil", want: []string{"de", "fil"}},
+		{name: "numeric region", envValue: "es_419", want: []string{"es-419"}},
+		{name: "order preserved", envValue: "pt_BR\nen\npt-br\nen", want: []string{"pt-BR", "en"}},
+		{name: "unset", envValue: "", want: []string{}},
+		{name: "language too long", envValue: "english", wantErr: `invalid locale "english" in TEST_LOCALES`},
+		{name: "language too short", envValue: "e-US", wantErr: "language must be 2-3 letters"},
+		{name: "invalid region", envValue: "en-USA", wantErr: "region must be 2 letters or 3 digits"},
+		{name: "empty region", envValue: "en-", wantErr: "region must be"},
+		{name: "extra subtag", envValue: "zh-Hans-CN", wantErr: "region must be"},
+		{name: "non-letters", envValue: "e1", wantErr: "language must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_LOCALES", tt.envValue)
+
+			got, err := ParseLocaleListEnv("TEST_LOCALES")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
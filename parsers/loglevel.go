@@ -0,0 +1,30 @@
+package parsers
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// ParseLogLevelEnv maps a log level environment variable such as LOG_LEVEL to a slog.Level.
+// Matching is case-insensitive and surrounding whitespace is ignored:
+//   - "debug"            => slog.LevelDebug
+//   - "info"             => slog.LevelInfo
+//   - "warn", "warning"  => slog.LevelWarn
+//   - "error"            => slog.LevelError
+//
+// Returns defaultLevel if the variable is not set, empty, or unrecognized.
+func ParseLogLevelEnv(envVar string, defaultLevel slog.Level) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(envVar))) {
+	case "debug":
+		return slog.LevelDebug
+	case "info":
+		return slog.LevelInfo
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return defaultLevel
+	}
+}
@@ -0,0 +1,35 @@
+package parsers
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestParseLogLevelEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected slog.Level
+	}{
+		{"Debug", "debug", slog.LevelDebug},
+		{"Info", "info", slog.LevelInfo},
+		{"Warn", "warn", slog.LevelWarn},
+		{"Warning alias", "warning", slog.LevelWarn},
+		{"Error", "error", slog.LevelError},
+		{"Mixed case and spaces", "  DeBuG ", slog.LevelDebug},
+		{"Upper warning", "WARNING", slog.LevelWarn},
+		{"Empty value", "", slog.LevelWarn},
+		{"Unrecognized value", "verbose", slog.LevelWarn},
+		{"Numeric value", "-4", slog.LevelWarn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_LOG_LEVEL", tt.envValue)
+
+			if got := ParseLogLevelEnv("TEST_LOG_LEVEL", slog.LevelWarn); got != tt.expected {
+				t.Fatalf("ParseLogLevelEnv(%q) = %v, want %v", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package parsers
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Endpoint is a host and TCP/UDP port pair.
+type Endpoint struct {
+	Host string
+	Port int
+}
+
+// String returns the endpoint in host:port form, bracketing IPv6 hosts.
+func (e Endpoint) String() string {
+	return net.JoinHostPort(e.Host, strconv.Itoa(e.Port))
+}
+
+// ParseCIDRListEnv reads an env var as multiline list (using ParseStringArrayEnv)
+// and parses each entry with net.ParseCIDR. IPv4 and IPv6 networks are accepted.
+// Returns an empty slice if the env var is unset or empty.
+// Returns an error naming the first invalid entry.
+func ParseCIDRListEnv(envVar string) ([]*net.IPNet, error) {
+	lines := ParseStringArrayEnv(envVar)
+	out := make([]*net.IPNet, 0, len(lines))
+
+	for _, line := range lines {
+		_, ipNet, err := net.ParseCIDR(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q in %s: %w", line, envVar, err)
+		}
+		out = append(out, ipNet)
+	}
+
+	return out, nil
+}
+
+// ParseEmailEnv parses a required email address with net/mail.ParseAddress.
+// Both "addr@host" and "Name <addr@host>" forms are accepted; only the address is returned.
+// Returns an error if the variable is unset or the value is not a single valid address.
+func ParseEmailEnv(envVar string) (string, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return "", fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	addr, err := mail.ParseAddress(val)
+	if err != nil {
+		return "", fmt.Errorf("invalid email address in %s: %q: %w", envVar, val, err)
+	}
+	return addr.Address, nil
+}
+
+// ParseEndpointListEnv reads an env var as multiline list (using ParseStringArrayEnv)
+// and parses each entry as "host:port", splitting on the last ':'. IPv6 hosts must be
+// bracketed ("[::1]:8080" or bare "[::1]"); brackets are stripped from Endpoint.Host.
+// Entries without a port get defaultPort.
+// Returns an empty slice if the env var is unset or empty.
+// Returns an error naming the first entry with an empty host, an unbracketed IPv6
+// address, or a port outside 1-65535 (including a missing port when defaultPort is
+// itself out of range).
+func ParseEndpointListEnv(envVar string, defaultPort int) ([]Endpoint, error) {
+	lines := ParseStringArrayEnv(envVar)
+	out := make([]Endpoint, 0, len(lines))
+
+	for _, line := range lines {
+		ep, err := parseEndpoint(line, defaultPort)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q in %s: %w", line, envVar, err)
+		}
+		out = append(out, ep)
+	}
+
+	return out, nil
+}
+
+// parseEndpoint parses a single host[:port] entry.
+func parseEndpoint(s string, defaultPort int) (Endpoint, error) {
+	host, port, hasPort := s, "", false
+
+	if rest, ok := strings.CutPrefix(s, "["); ok {
+		inner, after, found := strings.Cut(rest, "]")
+		if !found {
+			return Endpoint{}, fmt.Errorf("missing closing bracket")
+		}
+		host = inner
+		if after != "" {
+			port, hasPort = strings.CutPrefix(after, ":")
+			if !hasPort {
+				return Endpoint{}, fmt.Errorf("unexpected %q after bracketed host", after)
+			}
+		}
+	} else if strings.Count(s, ":") > 1 {
+		return Endpoint{}, fmt.Errorf("IPv6 addresses must be enclosed in brackets")
+	} else if i := strings.LastIndex(s, ":"); i >= 0 {
+		host, port, hasPort = s[:i], s[i+1:], true
+	}
+
+	if host == "" {
+		return Endpoint{}, fmt.Errorf("host is empty")
+	}
+
+	p := defaultPort
+	if hasPort {
+		n, err := strconv.Atoi(port)
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("invalid port %q", port)
+		}
+		p = n
+	}
+	if p < 1 || p > 65535 {
+		return Endpoint{}, fmt.Errorf("port %d out of range 1-65535", p)
+	}
+
+	return Endpoint{Host: host, Port: p}, nil
+}
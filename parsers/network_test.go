@@ -0,0 +1,178 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCIDRListEnv(t *testing.T) {
+	t.Run("valid IPv4 and IPv6", func(t *testing.T) {
+		t.Setenv("TEST_CIDRS", "10.0.0.0/8\n 192.168.1.0/24 \n\n2001:db8::/32")
+
+		got, err := ParseCIDRListEnv("TEST_CIDRS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []string{"10.0.0.0/8", "192.168.1.0/24", "2001:db8::/32"}
+		if len(got) != len(want) {
+			t.Fatalf("got %d networks, want %d", len(got), len(want))
+		}
+		for i, n := range got {
+			if n.String() != want[i] {
+				t.Fatalf("network %d = %q, want %q", i, n.String(), want[i])
+			}
+		}
+	})
+
+	t.Run("host bits are masked", func(t *testing.T) {
+		t.Setenv("TEST_CIDRS", "192.168.1.77/24")
+
+		got, err := ParseCIDRListEnv("TEST_CIDRS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0].String() != "192.168.1.0/24" {
+			t.Fatalf("got %v, want [192.168.1.0/24]", got)
+		}
+	})
+
+	t.Run("malformed entry", func(t *testing.T) {
+		t.Setenv("TEST_CIDRS", "10.0.0.0/8\n10.0.0.300/8")
+
+		_, err := ParseCIDRListEnv("TEST_CIDRS")
+		if err == nil || !strings.Contains(err.Error(), `"10.0.0.300/8"`) {
+			t.Fatalf("expected error naming the invalid entry, got %v", err)
+		}
+	})
+
+	t.Run("missing prefix length", func(t *testing.T) {
+		t.Setenv("TEST_CIDRS", "10.0.0.1")
+
+		if _, err := ParseCIDRListEnv("TEST_CIDRS"); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("unset returns empty slice", func(t *testing.T) {
+		t.Setenv("TEST_CIDRS", "")
+
+		got, err := ParseCIDRListEnv("TEST_CIDRS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %v, want empty slice", got)
+		}
+	})
+}
+
+func TestParseEmailEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+		wantErr  string
+	}{
+		{name: "bare address", envValue: "dev@example.com", want: "dev@example.com"},
+		{name: "trimmed bare address", envValue: "  dev@example.com \n", want: "dev@example.com"},
+		{name: "named address", envValue: "Jane Dev <jane@example.com>", want: "jane@example.com"},
+		{name: "quoted name", envValue: `"Dev, Team" <team@example.com>`, want: "team@example.com"},
+		{name: "invalid address", envValue: "not-an-email", wantErr: "invalid email address in TEST_NOTIFY_EMAIL"},
+		{name: "multiple addresses", envValue: "a@example.com, b@example.com", wantErr: "invalid email address"},
+		{name: "unset", envValue: "", wantErr: "required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_NOTIFY_EMAIL", tt.envValue)
+
+			got, err := ParseEmailEnv("TEST_NOTIFY_EMAIL")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEndpointListEnv(t *testing.T) {
+	t.Run("valid entries", func(t *testing.T) {
+		t.Setenv("TEST_ENDPOINTS", "db1:5432\n db2 \n[::1]:8080\n[2001:db8::1]\n\n10.0.0.1:80")
+
+		got, err := ParseEndpointListEnv("TEST_ENDPOINTS", 5432)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []Endpoint{
+			{Host: "db1", Port: 5432},
+			{Host: "db2", Port: 5432},
+			{Host: "::1", Port: 8080},
+			{Host: "2001:db8::1", Port: 5432},
+			{Host: "10.0.0.1", Port: 80},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("endpoint %d = %+v, want %+v", i, got[i], want[i])
+			}
+		}
+		if s := got[2].String(); s != "[::1]:8080" {
+			t.Fatalf("String() = %q, want %q", s, "[::1]:8080")
+		}
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Setenv("TEST_ENDPOINTS", "")
+
+		got, err := ParseEndpointListEnv("TEST_ENDPOINTS", 80)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected empty slice, got %v", got)
+		}
+	})
+
+	errCases := []struct {
+		name  string
+		value string
+		def   int
+		want  string
+	}{
+		{"non-numeric port", "db1:http", 80, `invalid port "http"`},
+		{"port zero", "db1:0", 80, "out of range"},
+		{"port too large", "db1:65536", 80, "out of range"},
+		{"empty port", "db1:", 80, `invalid port ""`},
+		{"missing host", ":5432", 80, "host is empty"},
+		{"unbracketed IPv6", "::1:8080", 80, "must be enclosed in brackets"},
+		{"unclosed bracket", "[::1:8080", 80, "missing closing bracket"},
+		{"junk after bracket", "[::1]8080", 80, "after bracketed host"},
+		{"no port and invalid default", "db1", 0, "out of range"},
+	}
+
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("TEST_ENDPOINTS", "ok:1\n"+tc.value)
+
+			_, err := ParseEndpointListEnv("TEST_ENDPOINTS", tc.def)
+			if err == nil {
+				t.Fatalf("expected error for %q", tc.value)
+			}
+			if !strings.Contains(err.Error(), tc.want) || !strings.Contains(err.Error(), "TEST_ENDPOINTS") {
+				t.Fatalf("error %q should mention %q and the env var", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package parsers
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ParseIntEnv retrieves an environment variable as a signed integer.
+// Unlike ParseUintEnv, zero and negative values are accepted.
+// Returns the default value if the variable is not set, empty, or invalid.
+func ParseIntEnv(envVar string, defaultVal int) int {
+	valStr := strings.TrimSpace(os.Getenv(envVar))
+	if valStr == "" {
+		return defaultVal
+	}
+	val, err := strconv.Atoi(valStr)
+	if err != nil {
+		return defaultVal
+	}
+	return val
+}
+
+// ParseInt64Env works like ParseIntEnv but always uses 64 bits,
+// which matters for large values (e.g. byte counts) on 32-bit platforms.
+// Returns the default value if the variable is not set, empty, or invalid.
+func ParseInt64Env(envVar string, defaultVal int64) int64 {
+	valStr := strings.TrimSpace(os.Getenv(envVar))
+	if valStr == "" {
+		return defaultVal
+	}
+	val, err := strconv.ParseInt(valStr, 10, 64)
+	if err != nil {
+		return defaultVal
+	}
+	return val
+}
+
+// ParseUintEnvClamped retrieves an environment variable as an integer and clamps it
+// into [minVal, maxVal]: a configured 1000 with maxVal 32 becomes 32, and values
+// below minVal (including zero or negatives) become minVal instead of falling back
+// to the default. When minVal == maxVal every valid value resolves to that bound.
+//
+// Returns the default value (unclamped) if the variable is not set, empty, or not an integer,
+// or if minVal > maxVal (e.g. bounds built from inconsistent config).
+func ParseUintEnvClamped(envVar string, defaultVal, minVal, maxVal int) int {
+	if minVal > maxVal {
+		return defaultVal
+	}
+
+	valStr := strings.TrimSpace(os.Getenv(envVar))
+	if valStr == "" {
+		return defaultVal
+	}
+	val, err := strconv.Atoi(valStr)
+	if err != nil {
+		return defaultVal
+	}
+	return max(minVal, min(val, maxVal))
+}
+
+// ParseFloatEnv retrieves an environment variable as a float64 (e.g. a 0.0-1.0 sampling rate).
+// The value is parsed with strconv.ParseFloat using 64-bit precision, so decimal
+// inputs are rounded to the nearest representable binary value: "0.1" yields the
+// float64 closest to 0.1, not exactly 0.1. Compare results with a tolerance if needed.
+//
+// Returns the default value if the variable is not set, empty, invalid,
+// out of float64 range, NaN, or ±Inf.
+func ParseFloatEnv(envVar string, defaultVal float64) float64 {
+	valStr := strings.TrimSpace(os.Getenv(envVar))
+	if valStr == "" {
+		return defaultVal
+	}
+	val, err := strconv.ParseFloat(valStr, 64)
+	if err != nil || math.IsNaN(val) || math.IsInf(val, 0) {
+		return defaultVal
+	}
+	return val
+}
+
+// byteUnits maps size suffixes to their base-1024 multipliers.
+// Longer suffixes come first so "KB" is not mistaken for "B".
+var byteUnits = []struct {
+	suffix string
+	mult   int64
+}{
+	{"GB", 1 << 30},
+	{"MB", 1 << 20},
+	{"KB", 1 << 10},
+	{"B", 1},
+}
+
+// ParseBytesEnv parses a human-readable size such as "512KB", "2MB" or "1 GB"
+// into a number of bytes. Suffixes B, KB, MB and GB are case-insensitive and
+// base-1024; a plain integer is a byte count. Only whole numbers are supported.
+//
+// Returns the default value if the variable is not set, empty, invalid,
+// negative, or overflows int64.
+func ParseBytesEnv(envVar string, defaultVal int64) int64 {
+	val := strings.ToUpper(strings.TrimSpace(os.Getenv(envVar)))
+	if val == "" {
+		return defaultVal
+	}
+
+	mult := int64(1)
+	for _, u := range byteUnits {
+		if num, ok := strings.CutSuffix(val, u.suffix); ok {
+			val, mult = strings.TrimSpace(num), u.mult
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || n < 0 || n > math.MaxInt64/mult {
+		return defaultVal
+	}
+	return n * mult
+}
+
+// ParseCountOrPercentEnv parses either an absolute count ("50") or a percentage
+// of total ("10%"). Percentages may be fractional ("12.5%") and are computed
+// as round(total * pct / 100), then clamped to [0, total].
+//
+// Returns defaultVal if the variable is not set or empty.
+// Returns an error if the value is malformed or the count is negative.
+func ParseCountOrPercentEnv(envVar string, total, defaultVal int) (int, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	if pctStr, ok := strings.CutSuffix(val, "%"); ok {
+		pct, err := strconv.ParseFloat(strings.TrimSpace(pctStr), 64)
+		if err != nil || math.IsNaN(pct) || math.IsInf(pct, 0) {
+			return 0, fmt.Errorf("invalid percentage for %s: %q", envVar, val)
+		}
+
+		n := math.Round(float64(total) * pct / 100)
+		return int(max(0, min(n, float64(total)))), nil
+	}
+
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid count for %s: %q", envVar, val)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("count for %s must not be negative: %q", envVar, val)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,205 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseIntEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected int
+	}{
+		{"Empty value", "", 10},
+		{"Whitespace input", "   ", 10},
+		{"Positive integer", "42", 42},
+		{"Zero value", "0", 0},
+		{"Negative value", "-5", -5},
+		{"Trimmed negative value", "  -5  ", -5},
+		{"Explicit plus sign", "+7", 7},
+		{"Non-numeric value", "abc", 10},
+		{"Decimal value", "1.5", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_INT", tt.envValue)
+
+			if got := ParseIntEnv("TEST_INT", 10); got != tt.expected {
+				t.Fatalf("ParseIntEnv(%q) = %d, want %d", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseInt64Env(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected int64
+	}{
+		{"Empty value", "", 10},
+		{"Zero value", "0", 0},
+		{"Negative value", " -5 ", -5},
+		{"Beyond 32-bit range", "8589934592", 8589934592},
+		{"Max int64", "9223372036854775807", 9223372036854775807},
+		{"Overflow", "9223372036854775808", 10},
+		{"Non-numeric value", "1O", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_INT64", tt.envValue)
+
+			if got := ParseInt64Env("TEST_INT64", 10); got != tt.expected {
+				t.Fatalf("ParseInt64Env(%q) = %d, want %d", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseUintEnvClamped(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		minVal   int
+		maxVal   int
+		expected int
+	}{
+		{"Empty value uses default", "", 1, 32, 4},
+		{"Invalid value uses default", "many", 1, 32, 4},
+		{"Within range", "8", 1, 32, 8},
+		{"Trimmed within range", "  16 ", 1, 32, 16},
+		{"Above max clamps down", "1000", 1, 32, 32},
+		{"Below min clamps up", "0", 1, 32, 1},
+		{"Negative clamps up", "-5", 2, 32, 2},
+		{"Equal bounds", "100", 8, 8, 8},
+		{"Equal bounds below", "1", 8, 8, 8},
+		{"Default is not clamped", "", 10, 20, 4},
+		{"Min greater than max uses default", "8", 10, 1, 4},
+		{"Min greater than max with empty value", "", 10, 1, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_WORKERS", tt.envValue)
+
+			if got := ParseUintEnvClamped("TEST_WORKERS", 4, tt.minVal, tt.maxVal); got != tt.expected {
+				t.Fatalf("ParseUintEnvClamped(%q, 4, %d, %d) = %d, want %d", tt.envValue, tt.minVal, tt.maxVal, got, tt.expected)
+			}
+		})
+	}
+
+}
+
+func TestParseFloatEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected float64
+	}{
+		{"Empty value", "", 0.5},
+		{"Whitespace input", " \t ", 0.5},
+		{"Zero", "0", 0},
+		{"One", "1.0", 1},
+		{"Fraction", "0.1", 0.1},
+		{"Trimmed fraction", "  0.25 \n", 0.25},
+		{"Negative", "-1.5", -1.5},
+		{"Exponent", "1e-3", 0.001},
+		{"Non-numeric value", "half", 0.5},
+		{"NaN", "NaN", 0.5},
+		{"Positive infinity", "+Inf", 0.5},
+		{"Negative infinity", "-inf", 0.5},
+		{"Out of range", "1e400", 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_FLOAT", tt.envValue)
+
+			if got := ParseFloatEnv("TEST_FLOAT", 0.5); got != tt.expected {
+				t.Fatalf("ParseFloatEnv(%q) = %v, want %v", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBytesEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected int64
+	}{
+		{"Empty value", "", 99},
+		{"Plain integer", "1024", 1024},
+		{"Zero", "0", 0},
+		{"Bytes suffix", "512B", 512},
+		{"Kilobytes", "512KB", 512 << 10},
+		{"Megabytes", "2MB", 2 << 20},
+		{"Gigabytes", "3GB", 3 << 30},
+		{"Lowercase suffix", "2mb", 2 << 20},
+		{"Space before suffix", " 4 kb ", 4 << 10},
+		{"Negative falls back", "-1MB", 99},
+		{"Fraction falls back", "1.5MB", 99},
+		{"Unknown suffix falls back", "2TB", 99},
+		{"Suffix only falls back", "MB", 99},
+		{"Overflow falls back", "9223372036854775807GB", 99},
+		{"Garbage falls back", "lots", 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_BYTES", tt.envValue)
+
+			if got := ParseBytesEnv("TEST_BYTES", 99); got != tt.expected {
+				t.Fatalf("ParseBytesEnv(%q) = %d, want %d", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseCountOrPercentEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		total    int
+		want     int
+		wantErr  string
+	}{
+		{name: "unset uses default", envValue: "", total: 200, want: 7},
+		{name: "ten percent", envValue: "10%", total: 200, want: 20},
+		{name: "absolute count", envValue: "50", total: 200, want: 50},
+		{name: "absolute count above total is kept", envValue: "500", total: 200, want: 500},
+		{name: "zero percent", envValue: "0%", total: 200, want: 0},
+		{name: "over 100 percent is clamped", envValue: "150%", total: 200, want: 200},
+		{name: "negative percent is clamped", envValue: "-5%", total: 200, want: 0},
+		{name: "rounds to nearest", envValue: "12.5%", total: 10, want: 1},
+		{name: "rounds half away from zero", envValue: "15%", total: 10, want: 2},
+		{name: "trimmed with inner space", envValue: "  25 % ", total: 8, want: 2},
+		{name: "invalid count", envValue: "many", total: 200, wantErr: "invalid count"},
+		{name: "invalid percentage", envValue: "x%", total: 200, wantErr: "invalid percentage"},
+		{name: "NaN percentage", envValue: "NaN%", total: 200, wantErr: "invalid percentage"},
+		{name: "negative count", envValue: "-3", total: 200, wantErr: "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_BATCH", tt.envValue)
+
+			got, err := ParseCountOrPercentEnv("TEST_BATCH", tt.total, 7)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
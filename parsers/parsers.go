@@ -3,6 +3,7 @@ package parsers
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"maps"
 	"os"
 	"path/filepath"
@@ -12,18 +13,32 @@ import (
 	yaml "go.yaml.in/yaml/v4"
 )
 
+// utf8BOM is the byte order mark some Windows editors prepend to copied values.
+const utf8BOM = "\ufeff"
+
 // ParseStringArrayEnv parses a string environment variable into an array of strings.
-// It trims spaces, normalizes line endings, and removes empty lines.
+// It strips a leading UTF-8 BOM, trims spaces, normalizes line endings, and removes empty lines.
 func ParseStringArrayEnv(envVar string) []string {
-	val := os.Getenv(envVar)
+	return parseStringArray(os.Getenv(envVar))
+}
+
+// ParseStringArray reads r to the end and parses its content like ParseStringArrayEnv.
+// It lets callers feed a string (strings.NewReader) or a file directly.
+// Returns an error only if reading from r fails.
+func ParseStringArray(r io.Reader) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read string array: %w", err)
+	}
+	return parseStringArray(string(data)), nil
+}
+
+func parseStringArray(val string) []string {
 	if val == "" {
 		return []string{}
 	}
 
-	val = strings.ReplaceAll(val, "\r\n", "\n")
-	val = strings.ReplaceAll(val, "\r", "\n")
-
-	lines := strings.Split(val, "\n")
+	lines := splitLines(val)
 	result := make([]string, 0, len(lines))
 
 	for _, line := range lines {
@@ -36,6 +51,112 @@ func ParseStringArrayEnv(envVar string) []string {
 	return result
 }
 
+// ParseStringArrayEnvRequired works like ParseStringArrayEnv but returns an error
+// if the variable is not set or contains no non-empty lines.
+func ParseStringArrayEnvRequired(envVar string) ([]string, error) {
+	result := ParseStringArrayEnv(envVar)
+	if len(result) == 0 {
+		return nil, fmt.Errorf("environment variable %s is required", envVar)
+	}
+	return result, nil
+}
+
+// ParseStringArrayEnvRaw parses a string environment variable into an array of strings
+// like ParseStringArrayEnv, but keeps leading, trailing and internal whitespace of each
+// retained line. Line endings are still normalized and a leading UTF-8 BOM is stripped.
+// Empty and whitespace-only lines are dropped, so a line of spaces is never emitted.
+func ParseStringArrayEnvRaw(envVar string) []string {
+	lines, _ := ParseLinesEnv(envVar, LineOpts{DropBlank: true})
+	return lines
+}
+
+// ParseStringArrayEnvExpand parses a string environment variable like ParseStringArrayEnv,
+// then expands $VAR and ${VAR} references in each retained line with os.ExpandEnv.
+// Following os.ExpandEnv semantics, unknown variables expand to an empty string,
+// and entries that become empty after expansion are kept.
+func ParseStringArrayEnvExpand(envVar string) []string {
+	lines := ParseStringArrayEnv(envVar)
+
+	for i, line := range lines {
+		lines[i] = os.ExpandEnv(line)
+	}
+
+	return lines
+}
+
+// ParseStringArrayEnvValidated parses a string environment variable like ParseStringArrayEnv
+// and applies validate to each retained line, in order.
+// Returns the same slice ParseStringArrayEnv would if every line passes.
+// Returns the first failure, naming the line, its index in the retained lines and envVar,
+// and wrapping the validator's error.
+func ParseStringArrayEnvValidated(envVar string, validate func(string) error) ([]string, error) {
+	return ParseLinesEnv(envVar, LineOpts{Trim: true, DropBlank: true, Validate: validate})
+}
+
+// ParseStringArrayEnvSep parses a string environment variable into an array of strings
+// split on sep (e.g. "," or ";"). Like ParseStringArrayEnv, it strips a leading UTF-8 BOM,
+// trims each element, and removes empty ones. When sep is "\n", "\r\n" and "\r" are
+// normalized first. An empty sep does not split: the trimmed value is returned as a
+// single element (or no elements if it is blank).
+//
+// Example:
+//
+//	ParseStringArrayEnvSep("LANGS", ",") // LANGS="a, b,,c" => []string{"a", "b", "c"}
+func ParseStringArrayEnvSep(envVar string, sep string) []string {
+	if sep == "\n" {
+		return ParseStringArrayEnv(envVar)
+	}
+
+	val := strings.TrimPrefix(os.Getenv(envVar), utf8BOM)
+	if sep == "" {
+		if val = strings.TrimSpace(val); val == "" {
+			return []string{}
+		}
+		return []string{val}
+	}
+
+	parts := strings.Split(val, sep)
+	result := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
+
+// splitLines strips a leading UTF-8 BOM, normalizes "\r\n" and "\r" to "\n",
+// and splits the value into lines. BOMs in the middle of the value are kept.
+func splitLines(val string) []string {
+	val = strings.TrimPrefix(val, utf8BOM)
+	val = strings.ReplaceAll(val, "\r\n", "\n")
+	val = strings.ReplaceAll(val, "\r", "\n")
+
+	return strings.Split(val, "\n")
+}
+
+// ParseStringSetEnv parses a string environment variable like ParseStringArrayEnv
+// but returns the entries as a set for fast membership tests. Duplicates collapse.
+func ParseStringSetEnv(envVar string) map[string]struct{} {
+	entries := ParseStringArrayEnv(envVar)
+	set := make(map[string]struct{}, len(entries))
+
+	for _, e := range entries {
+		set[e] = struct{}{}
+	}
+
+	return set
+}
+
+// Contains reports whether key is present in set.
+func Contains(set map[string]struct{}, key string) bool {
+	_, ok := set[key]
+	return ok
+}
+
 // EnsureRepoRelativePattern validates a single repo-relative path or pattern.
 // Allowed:
 //   - "." => repo root
@@ -52,16 +173,28 @@ func ParseStringArrayEnv(envVar string) []string {
 //
 // Returns a cleaned path/pattern (OS-native separators). Caller may ToSlash it.
 func EnsureRepoRelativePattern(p string) (string, error) {
+	return ensureRepoRelativePattern(p, false)
+}
+
+// ensureRepoRelativePattern implements EnsureRepoRelativePattern. If backslashSep is set,
+// backslashes are turned into forward slashes before validation; error messages still
+// quote the (trimmed) input as the user wrote it.
+func ensureRepoRelativePattern(p string, backslashSep bool) (string, error) {
 	p = strings.TrimSpace(p)
 	if p == "" {
 		return "", fmt.Errorf("empty path")
 	}
 
+	orig := p
+	if backslashSep {
+		p = strings.ReplaceAll(p, `\`, "/")
+	}
+
 	if strings.ContainsRune(p, '\x00') {
 		return "", fmt.Errorf("invalid path: contains NUL")
 	}
 	if strings.HasPrefix(p, "~") {
-		return "", fmt.Errorf("path must be relative to repo (no ~ expansion): %q", p)
+		return "", fmt.Errorf("path must be relative to repo (no ~ expansion): %q", orig)
 	}
 
 	clean := filepath.Clean(p)
@@ -71,22 +204,22 @@ func EnsureRepoRelativePattern(p string) (string, error) {
 	}
 
 	if filepath.IsAbs(clean) {
-		return "", fmt.Errorf("path must be relative to repo: %q", p)
+		return "", fmt.Errorf("path must be relative to repo: %q", orig)
 	}
 
 	s := filepath.ToSlash(clean)
 
 	if strings.HasPrefix(s, "/") {
-		return "", fmt.Errorf("path must be relative to repo: %q", p)
+		return "", fmt.Errorf("path must be relative to repo: %q", orig)
 	}
 
 	if s == ".." || strings.HasPrefix(s, "../") {
-		return "", fmt.Errorf("path escapes repo root: %q", p)
+		return "", fmt.Errorf("path escapes repo root: %q", orig)
 	}
 
 	// Windows drive-relative "C:foo"
 	if len(s) >= 2 && s[1] == ':' && ((s[0] >= 'A' && s[0] <= 'Z') || (s[0] >= 'a' && s[0] <= 'z')) {
-		return "", fmt.Errorf("path must be relative (drive-prefixed): %q", p)
+		return "", fmt.Errorf("path must be relative (drive-prefixed): %q", orig)
 	}
 
 	return clean, nil
@@ -94,8 +227,9 @@ func EnsureRepoRelativePattern(p string) (string, error) {
 
 // EnsureRepoRelativePath validates a single path is repo-relative and safe.
 // Same rules as EnsureRepoRelativePattern, but glob metacharacters are forbidden.
+// Backslashes are treated as separators on every OS, so "locales\en" becomes "locales/en".
 func EnsureRepoRelativePath(p string) (string, error) {
-	clean, err := EnsureRepoRelativePattern(p)
+	clean, err := ensureRepoRelativePattern(p, true)
 	if err != nil {
 		return "", err
 	}
@@ -113,16 +247,76 @@ func EnsureRepoRelativePath(p string) (string, error) {
 // deduplicates (order-preserving), and returns the set.
 // Returns an error if the env var is empty or any entry is invalid.
 func ParseRepoRelativePathsEnv(envVar string) ([]string, error) {
+	return parseRepoRelativeEnv(envVar, EnsureRepoRelativePath)
+}
+
+// ParseRepoRelativePathsEnvFold works like ParseRepoRelativePathsEnv but deduplicates
+// case-insensitively, for case-insensitive filesystems: "Locales", "locales" and
+// "LOCALES" collapse into one entry. The first-seen spelling is kept, in input order.
+func ParseRepoRelativePathsEnvFold(envVar string) ([]string, error) {
+	paths, err := ParseRepoRelativePathsEnv(envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(paths))
+	out := paths[:0]
+
+	for _, p := range paths {
+		key := strings.ToLower(p)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, p)
+	}
+
+	return out, nil
+}
+
+// ParseRepoRelativeGlobsEnv works like ParseRepoRelativePathsEnv but validates each
+// item with EnsureRepoRelativePattern, so glob metacharacters ("**/*.json", "file?.yml",
+// "[ab].yaml") are allowed. The other safety rules still apply: absolute, UNC,
+// drive-prefixed and parent-escaping patterns such as "../*" are rejected.
+// Backslashes are treated as path separators, as in EnsureRepoRelativePath.
+func ParseRepoRelativeGlobsEnv(envVar string) ([]string, error) {
+	return parseRepoRelativeEnv(envVar, ensureRepoRelativeGlob)
+}
+
+// ensureRepoRelativeGlob validates p with the EnsureRepoRelativePattern rules, treating
+// backslashes as separators like EnsureRepoRelativePath, and returns the cleaned pattern.
+// Backslash glob escapes are therefore not supported, but `..\*`, `.\./*` and
+// `\\server\share\*` are checked and mean the same on every OS.
+func ensureRepoRelativeGlob(p string) (string, error) {
+	return ensureRepoRelativePattern(p, true)
+}
+
+// parseRepoRelativeEnv implements ParseRepoRelativePathsEnv and ParseRepoRelativeGlobsEnv,
+// validating each entry with ensure.
+func parseRepoRelativeEnv(envVar string, ensure func(string) (string, error)) ([]string, error) {
 	raw := ParseStringArrayEnv(envVar)
 	if len(raw) == 0 {
 		return nil, fmt.Errorf("environment variable %s is required", envVar)
 	}
 
 	seen := make(map[string]struct{}, len(raw))
-	out := make([]string, 0, len(raw))
+	out, err := appendRepoRelativePaths(make([]string, 0, len(raw)), seen, envVar, raw, ensure)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no valid paths found in %s", envVar)
+	}
+	return out, nil
+}
 
+// appendRepoRelativePaths validates raw entries read from envVar with ensure
+// (EnsureRepoRelativePath or EnsureRepoRelativePattern), normalizes them to
+// forward slashes, and appends the ones not yet in seen to out.
+func appendRepoRelativePaths(out []string, seen map[string]struct{}, envVar string, raw []string, ensure func(string) (string, error)) ([]string, error) {
 	for _, p := range raw {
-		clean, err := EnsureRepoRelativePath(p)
+		clean, err := ensure(p)
 		if err != nil {
 			return nil, fmt.Errorf("invalid path %q in %s: %w", p, envVar, err)
 		}
@@ -134,28 +328,109 @@ func ParseRepoRelativePathsEnv(envVar string) ([]string, error) {
 		out = append(out, norm)
 	}
 
-	if len(out) == 0 {
-		return nil, fmt.Errorf("no valid paths found in %s", envVar)
-	}
 	return out, nil
 }
 
+// GroupPathsByRoot reads an env var as multiline list (using ParseStringArrayEnv),
+// validates each entry with EnsureRepoRelativePath, normalizes it to forward slashes,
+// and buckets entries by their leading directory component. Order within each bucket
+// follows the input order. Entries without a separator (e.g. "locales") are grouped
+// under their own name; the repo root "." is grouped under ".".
+// Returns an empty map if the env var is unset or empty.
+// Returns an error naming the first invalid entry (absolute, parent-escaping, etc.).
+//
+// Example:
+//
+//	input:  "app/locales/en.json\nweb/i18n\napp/strings\nREADME.md"
+//	output: {"app": ["app/locales/en.json", "app/strings"], "web": ["web/i18n"], "README.md": ["README.md"]}
+func GroupPathsByRoot(envVar string) (map[string][]string, error) {
+	groups := make(map[string][]string)
+
+	for _, p := range ParseStringArrayEnv(envVar) {
+		clean, err := EnsureRepoRelativePath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q in %s: %w", p, envVar, err)
+		}
+		norm := filepath.ToSlash(clean)
+
+		root, _, _ := strings.Cut(norm, "/")
+		groups[root] = append(groups[root], norm)
+	}
+
+	return groups, nil
+}
+
 // ParseBoolEnv parses a boolean environment variable.
+// Surrounding whitespace is ignored and matching is case-insensitive. Accepted values:
+//   - true:  "1", "t", "true", "y", "yes", "on"
+//   - false: "0", "f", "false", "n", "no", "off"
+//
 // Returns false if the variable is not set or empty.
-// Returns an error if the value cannot be parsed as a boolean.
+// Returns an error if the value is not one of the recognized tokens.
 func ParseBoolEnv(envVar string) (bool, error) {
+	return ParseBool(os.Getenv(envVar))
+}
+
+// ParseBoolEnvRequired works like ParseBoolEnv but returns an error
+// if the variable is not set or empty.
+func ParseBoolEnvRequired(envVar string) (bool, error) {
 	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return false, fmt.Errorf("environment variable %s is required", envVar)
+	}
+	return ParseBool(val)
+}
+
+// ParseBoolEnvRaw works like ParseBoolEnv but also returns the original,
+// untrimmed env value (empty if unset) so callers can report exactly what was supplied.
+func ParseBoolEnvRaw(envVar string) (value bool, raw string, err error) {
+	raw = os.Getenv(envVar)
+	value, err = ParseBool(raw)
+	return value, raw, err
+}
+
+// ParseBool parses a raw boolean value with the same rules as ParseBoolEnv.
+// Returns false if raw is empty or whitespace-only.
+func ParseBool(raw string) (bool, error) {
+	val := strings.TrimSpace(raw)
 	if val == "" {
 		return false, nil
 	}
 
-	return strconv.ParseBool(val)
+	return parseBoolLenient(val)
 }
 
 // ParseUintEnv retrieves an environment variable as a positive integer.
 // Returns the default value if the variable is not set, invalid, or less than 1.
 func ParseUintEnv(envVar string, defaultVal int) int {
+	return ParseUint(os.Getenv(envVar), defaultVal)
+}
+
+// ParseUintEnvRequired retrieves an environment variable as a positive integer.
+// Returns an error if the variable is not set or empty, is not an integer, or is less than 1.
+func ParseUintEnvRequired(envVar string) (int, error) {
 	valStr := strings.TrimSpace(os.Getenv(envVar))
+	if valStr == "" {
+		return 0, fmt.Errorf("environment variable %s is required", envVar)
+	}
+	val, err := strconv.Atoi(valStr)
+	if err != nil || val < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer: %q", envVar, valStr)
+	}
+	return val, nil
+}
+
+// ParseUintEnvRaw works like ParseUintEnv but also returns the original,
+// untrimmed env value (empty if unset), e.g. to log "MAX='1O' is invalid, using 10".
+func ParseUintEnvRaw(envVar string, defaultVal int) (value int, raw string) {
+	raw = os.Getenv(envVar)
+	return ParseUint(raw, defaultVal), raw
+}
+
+// ParseUint parses a raw positive integer with the same rules as ParseUintEnv.
+// Returns the default value if raw is empty, invalid, or less than 1.
+func ParseUint(raw string, defaultVal int) int {
+	valStr := strings.TrimSpace(raw)
 	if valStr == "" {
 		return defaultVal
 	}
@@ -166,6 +441,39 @@ func ParseUintEnv(envVar string, defaultVal int) int {
 	return val
 }
 
+// ParseStringEnvOrDefault returns the trimmed value of an environment variable.
+// Returns defaultVal if the variable is not set or contains only whitespace.
+func ParseStringEnvOrDefault(envVar, defaultVal string) string {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal
+	}
+	return val
+}
+
+// ParseStringEnvRaw returns the trimmed value of an environment variable
+// together with the original, untrimmed value (empty if unset).
+func ParseStringEnvRaw(envVar string) (value string, raw string) {
+	raw = os.Getenv(envVar)
+	return strings.TrimSpace(raw), raw
+}
+
+// ParseFirstSetEnv returns the trimmed value of the first key that is set and
+// non-empty after trimming, together with the key that supplied it.
+// Useful for renamed variables: pass the new name first and the deprecated one after,
+// then check usedKey to decide whether to emit a deprecation warning.
+// found is false (and value/usedKey are empty) if none of the keys is set.
+func ParseFirstSetEnv(keys ...string) (value string, usedKey string, found bool) {
+	for _, key := range keys {
+		val := strings.TrimSpace(os.Getenv(key))
+		if val != "" {
+			return val, key, true
+		}
+	}
+
+	return "", "", false
+}
+
 // ParseLangEnv read and validates lang value from
 // env variable.
 func ParseLangEnv(envVar string) (string, error) {
@@ -221,6 +529,26 @@ func ParseObject(raw string) (map[string]any, error) {
 	return parseYAMLMap(raw)
 }
 
+// ParseJSONArrayEnv decodes an environment variable holding a JSON array into []T,
+// preserving element order.
+// Returns an empty slice if the variable is not set, empty, or JSON null.
+// Returns a decode error for malformed JSON or elements that do not fit T.
+func ParseJSONArrayEnv[T any](envVar string) ([]T, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return []T{}, nil
+	}
+
+	var out []T
+	if err := json.Unmarshal([]byte(val), &out); err != nil {
+		return nil, fmt.Errorf("invalid JSON array in %s: %w", envVar, err)
+	}
+	if out == nil {
+		return []T{}, nil
+	}
+	return out, nil
+}
+
 // parseYAMLMap parses a YAML mapping into map[string]any.
 func parseYAMLMap(s string) (map[string]any, error) {
 	var m map[string]any
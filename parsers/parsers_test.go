@@ -1,11 +1,14 @@
 package parsers
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func TestParseStringArrayEnv(t *testing.T) {
@@ -75,6 +78,24 @@ func TestParseStringArrayEnv(t *testing.T) {
 			envValue: "",
 			expected: []string{},
 		},
+		{
+			name:     "Leading BOM on single path",
+			envKey:   "TEST_ENV",
+			envValue: "\ufefflocales",
+			expected: []string{"locales"},
+		},
+		{
+			name:     "Leading BOM on multi-line value",
+			envKey:   "TEST_ENV",
+			envValue: "\ufeffen\r\nfr\nde",
+			expected: []string{"en", "fr", "de"},
+		},
+		{
+			name:     "Mid-content BOM is kept",
+			envKey:   "TEST_ENV",
+			envValue: "en\n\ufefffr",
+			expected: []string{"en", "\ufefffr"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -89,6 +110,298 @@ func TestParseStringArrayEnv(t *testing.T) {
 	}
 }
 
+func TestParseStringArray(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Multiple lines", "a\nb\nc", []string{"a", "b", "c"}},
+		{"Mixed newlines and trimming", " a \r\nb\r c \n\n", []string{"a", "b", "c"}},
+		{"Leading BOM", "\ufeffen\nfr", []string{"en", "fr"}},
+		{"Only whitespace", "\n \n\t", []string{}},
+		{"Empty input", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseStringArray(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("ParseStringArray(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("file input", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "paths.txt")
+		if err := os.WriteFile(path, []byte("locales\r\n\ni18n\n"), 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		defer f.Close()
+
+		got, err := ParseStringArray(f)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, []string{"locales", "i18n"}) {
+			t.Fatalf("got %v, want [locales i18n]", got)
+		}
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+
+		readErr := errors.New("boom")
+		_, err := ParseStringArray(iotest.ErrReader(readErr))
+		if !errors.Is(err, readErr) {
+			t.Fatalf("expected wrapped read error, got %v", err)
+		}
+	})
+}
+
+func TestParseBool(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw      string
+		expected bool
+		wantErr  bool
+	}{
+		{"", false, false},
+		{"  ", false, false},
+		{"true", true, false},
+		{" Yes ", true, false},
+		{"off", false, false},
+		{"0", false, false},
+		{"nope", false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBool(tt.raw)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParseBool(%q) error = %v, wantErr = %v", tt.raw, err, tt.wantErr)
+		}
+		if got != tt.expected {
+			t.Fatalf("ParseBool(%q) = %v, want %v", tt.raw, got, tt.expected)
+		}
+	}
+}
+
+func TestParseUint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw      string
+		expected int
+	}{
+		{"", 10},
+		{"42", 42},
+		{"  7 \n", 7},
+		{"0", 10},
+		{"-3", 10},
+		{"abc", 10},
+	}
+
+	for _, tt := range tests {
+		if got := ParseUint(tt.raw, 10); got != tt.expected {
+			t.Fatalf("ParseUint(%q, 10) = %d, want %d", tt.raw, got, tt.expected)
+		}
+	}
+}
+
+func TestParseStringArrayEnvRaw(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected []string
+	}{
+		{"Whitespace preserved", "  key one \nkey two  ", []string{"  key one ", "key two  "}},
+		{"Tabs preserved", "\tindented\t", []string{"\tindented\t"}},
+		{"Line endings normalized", "a\r\n b\r c ", []string{"a", " b", " c "}},
+		{"Whitespace-only lines dropped", "a\n   \n\t\nb", []string{"a", "b"}},
+		{"Empty lines dropped", "\n\na\n\n", []string{"a"}},
+		{"Leading BOM stripped", "\ufeff x", []string{" x"}},
+		{"Empty value", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV", tt.envValue)
+
+			result := ParseStringArrayEnvRaw("TEST_ENV")
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Fatalf("ParseStringArrayEnvRaw(%q) = %q, want %q", tt.envValue, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseStringArrayEnvExpand(t *testing.T) {
+	t.Setenv("TEST_EXPAND_HOME", "/home/dev")
+	t.Setenv("TEST_EXPAND_APP", "web")
+	t.Setenv("TEST_EXPAND_MISSING", "")
+	os.Unsetenv("TEST_EXPAND_MISSING")
+
+	tests := []struct {
+		name     string
+		envValue string
+		expected []string
+	}{
+		{"Braced reference", "${TEST_EXPAND_HOME}/locales", []string{"/home/dev/locales"}},
+		{"Bare reference", "$TEST_EXPAND_APP/i18n", []string{"web/i18n"}},
+		{"Multiple references", " ${TEST_EXPAND_HOME}/${TEST_EXPAND_APP} \nplain", []string{"/home/dev/web", "plain"}},
+		{"Unknown variable expands to empty", "${TEST_EXPAND_MISSING}/locales", []string{"/locales"}},
+		{"Empty after expansion is kept", "${TEST_EXPAND_MISSING}", []string{""}},
+		{"Empty value", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV", tt.envValue)
+
+			result := ParseStringArrayEnvExpand("TEST_ENV")
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Fatalf("ParseStringArrayEnvExpand(%q) = %q, want %q", tt.envValue, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseStringArrayEnvValidated(t *testing.T) {
+	errUpper := errors.New("must be lowercase")
+	lower := func(s string) error {
+		if s != strings.ToLower(s) {
+			return errUpper
+		}
+		return nil
+	}
+
+	t.Run("all lines pass", func(t *testing.T) {
+		t.Setenv("TEST_ENV", " en \n\nfr\r\nde")
+
+		got, err := ParseStringArrayEnvValidated("TEST_ENV", lower)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"en", "fr", "de"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("empty value", func(t *testing.T) {
+		t.Setenv("TEST_ENV", "")
+
+		got, err := ParseStringArrayEnvValidated("TEST_ENV", lower)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("expected empty slice, got %q", got)
+		}
+	})
+
+	t.Run("first failure is reported", func(t *testing.T) {
+		t.Setenv("TEST_ENV", "en\n\nFR\nDE")
+
+		got, err := ParseStringArrayEnvValidated("TEST_ENV", lower)
+		if err == nil {
+			t.Fatalf("expected error, got %q", got)
+		}
+		if !errors.Is(err, errUpper) {
+			t.Fatalf("error should wrap validator error, got %v", err)
+		}
+		for _, want := range []string{`"FR"`, "index 1", "TEST_ENV"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Fatalf("error %q should contain %q", err, want)
+			}
+		}
+	})
+}
+
+func TestParseStringArrayEnvSep(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		sep      string
+		expected []string
+	}{
+		{"Comma separated", "a, b, c", ",", []string{"a", "b", "c"}},
+		{"Semicolon separated", "a;b ; c;", ";", []string{"a", "b", "c"}},
+		{"Empty elements dropped", ",a,, ,b,", ",", []string{"a", "b"}},
+		{"Multi-char separator", "a::b:: c", "::", []string{"a", "b", "c"}},
+		{"Newline separator normalizes CRLF", "a\r\nb\rc\n", "\n", []string{"a", "b", "c"}},
+		{"Leading BOM stripped", "\ufeffa,b", ",", []string{"a", "b"}},
+		{"Empty separator keeps whole value", "  a, b ; c  ", "", []string{"a, b ; c"}},
+		{"Empty separator and blank value", "   ", "", []string{}},
+		{"Empty value", "", ",", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV", tt.envValue)
+
+			result := ParseStringArrayEnvSep("TEST_ENV", tt.sep)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Fatalf("ParseStringArrayEnvSep(%q, %q) = %v, want %v", tt.envValue, tt.sep, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseStringSetEnv(t *testing.T) {
+	t.Run("duplicates collapse and membership works", func(t *testing.T) {
+		t.Setenv("TEST_SET", "en\n fr \nen\r\nde\n\n")
+
+		got := ParseStringSetEnv("TEST_SET")
+		want := map[string]struct{}{"en": {}, "fr": {}, "de": {}}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		for _, k := range []string{"en", "fr", "de"} {
+			if !Contains(got, k) {
+				t.Fatalf("Contains(%q) = false, want true", k)
+			}
+		}
+		for _, k := range []string{"es", "EN", " fr "} {
+			if Contains(got, k) {
+				t.Fatalf("Contains(%q) = true, want false", k)
+			}
+		}
+	})
+
+	t.Run("empty env returns empty set", func(t *testing.T) {
+		t.Setenv("TEST_SET", "")
+
+		got := ParseStringSetEnv("TEST_SET")
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %v, want empty set", got)
+		}
+		if Contains(got, "en") {
+			t.Fatal("empty set must not contain anything")
+		}
+	})
+
+	t.Run("nil set contains nothing", func(t *testing.T) {
+		if Contains(nil, "en") {
+			t.Fatal("nil set must not contain anything")
+		}
+	})
+}
+
 func TestParseBoolEnv(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -108,6 +421,16 @@ func TestParseBoolEnv(t *testing.T) {
 		{"Trimmed true value", "TRIMMED_TRUE_ENV", "  true \n", true, false},
 		{"Trimmed false value", "TRIMMED_FALSE_ENV", "\t false  ", false, false},
 		{"Whitespace around invalid value", "TRIMMED_INVALID_ENV", "  nope  ", false, true},
+		{"Mixed case true", "MIXED_TRUE_ENV", "tRuE", true, false},
+		{"yes", "YES_ENV", "yes", true, false},
+		{"YES uppercase", "YES_UPPER_ENV", "YES", true, false},
+		{"y", "Y_ENV", "y", true, false},
+		{"on", "ON_ENV", " On ", true, false},
+		{"no", "NO_ENV", "no", false, false},
+		{"n", "N_ENV", "N", false, false},
+		{"off", "OFF_ENV", "OFF", false, false},
+		{"Typo of yes", "TYPO_ENV", "yess", false, true},
+		{"Partial token", "PARTIAL_ENV", "of", false, true},
 	}
 
 	for _, tt := range tests {
@@ -127,6 +450,15 @@ func TestParseBoolEnv(t *testing.T) {
 	}
 }
 
+func TestParseBoolEnv_ErrorIsSyntaxError(t *testing.T) {
+	t.Setenv("TEST_BOOL", "maybe")
+
+	_, err := ParseBoolEnv("TEST_BOOL")
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected strconv.ErrSyntax, got %v", err)
+	}
+}
+
 func TestParseBoolEnv_UnsetVariable(t *testing.T) {
 	key := "SOME_UNSET_ENV_FOR_TEST"
 
@@ -175,6 +507,234 @@ func TestParseUintEnv(t *testing.T) {
 	}
 }
 
+func TestParseStringEnvOrDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue *string
+		want     string
+	}{
+		{name: "unset", envValue: nil, want: "fallback"},
+		{name: "empty", envValue: new(""), want: "fallback"},
+		{name: "whitespace only", envValue: new(" \t\r\n "), want: "fallback"},
+		{name: "real value", envValue: new("value"), want: "value"},
+		{name: "real value is trimmed", envValue: new("  value \n"), want: "value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_STRING", "")
+			os.Unsetenv("TEST_STRING")
+			if tt.envValue != nil {
+				t.Setenv("TEST_STRING", *tt.envValue)
+			}
+
+			if got := ParseStringEnvOrDefault("TEST_STRING", "fallback"); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsers_TreatWhitespaceOnlyAsUnset(t *testing.T) {
+	t.Setenv("TEST_BLANK", "  \t \n ")
+
+	if got := ParseStringArrayEnv("TEST_BLANK"); len(got) != 0 {
+		t.Fatalf("ParseStringArrayEnv = %v, want empty", got)
+	}
+	if got, err := ParseBoolEnv("TEST_BLANK"); err != nil || got {
+		t.Fatalf("ParseBoolEnv = (%v, %v), want (false, nil)", got, err)
+	}
+	if got := ParseUintEnv("TEST_BLANK", 3); got != 3 {
+		t.Fatalf("ParseUintEnv = %d, want 3", got)
+	}
+	if got, err := ParseTriStateEnv("TEST_BLANK"); err != nil || got != TriStateAuto {
+		t.Fatalf("ParseTriStateEnv = (%v, %v), want (auto, nil)", got, err)
+	}
+	if _, _, found := ParseFirstSetEnv("TEST_BLANK"); found {
+		t.Fatal("ParseFirstSetEnv treated whitespace-only value as set")
+	}
+	if _, err := ParseLangEnv("TEST_BLANK"); err == nil {
+		t.Fatal("ParseLangEnv accepted whitespace-only value")
+	}
+	if _, err := ParseRepoRelativePathsEnv("TEST_BLANK"); err == nil || !strings.Contains(err.Error(), "required") {
+		t.Fatalf("ParseRepoRelativePathsEnv error = %v, want required error", err)
+	}
+}
+
+func TestParseRawVariants_PreserveOriginalValue(t *testing.T) {
+	t.Run("uint invalid value keeps raw verbatim", func(t *testing.T) {
+		t.Setenv("TEST_RAW", "  1O \n")
+
+		got, raw := ParseUintEnvRaw("TEST_RAW", 10)
+		if got != 10 {
+			t.Fatalf("value = %d, want 10", got)
+		}
+		if raw != "  1O \n" {
+			t.Fatalf("raw = %q, want %q", raw, "  1O \n")
+		}
+	})
+
+	t.Run("uint valid value", func(t *testing.T) {
+		t.Setenv("TEST_RAW", " 42 ")
+
+		got, raw := ParseUintEnvRaw("TEST_RAW", 10)
+		if got != 42 || raw != " 42 " {
+			t.Fatalf("got (%d, %q), want (42, %q)", got, raw, " 42 ")
+		}
+	})
+
+	t.Run("uint unset returns empty raw", func(t *testing.T) {
+		t.Setenv("TEST_RAW", "")
+		os.Unsetenv("TEST_RAW")
+
+		got, raw := ParseUintEnvRaw("TEST_RAW", 7)
+		if got != 7 || raw != "" {
+			t.Fatalf("got (%d, %q), want (7, \"\")", got, raw)
+		}
+	})
+
+	t.Run("bool valid value", func(t *testing.T) {
+		t.Setenv("TEST_RAW", "\t true ")
+
+		got, raw, err := ParseBoolEnvRaw("TEST_RAW")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !got || raw != "\t true " {
+			t.Fatalf("got (%v, %q), want (true, %q)", got, raw, "\t true ")
+		}
+	})
+
+	t.Run("bool invalid value keeps raw and error", func(t *testing.T) {
+		t.Setenv("TEST_RAW", " nope ")
+
+		_, raw, err := ParseBoolEnvRaw("TEST_RAW")
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if raw != " nope " {
+			t.Fatalf("raw = %q, want %q", raw, " nope ")
+		}
+	})
+
+	t.Run("string value is trimmed, raw is not", func(t *testing.T) {
+		t.Setenv("TEST_RAW", "  hello world \r\n")
+
+		got, raw := ParseStringEnvRaw("TEST_RAW")
+		if got != "hello world" {
+			t.Fatalf("value = %q, want %q", got, "hello world")
+		}
+		if raw != "  hello world \r\n" {
+			t.Fatalf("raw = %q, want %q", raw, "  hello world \r\n")
+		}
+	})
+}
+
+func TestParseFirstSetEnv(t *testing.T) {
+	t.Run("first key set", func(t *testing.T) {
+		t.Setenv("TEST_NEW_NAME", " new ")
+		t.Setenv("TEST_OLD_NAME", "old")
+
+		val, key, found := ParseFirstSetEnv("TEST_NEW_NAME", "TEST_OLD_NAME")
+		if !found || val != "new" || key != "TEST_NEW_NAME" {
+			t.Fatalf("got (%q, %q, %v), want (\"new\", \"TEST_NEW_NAME\", true)", val, key, found)
+		}
+	})
+
+	t.Run("falls back to deprecated key", func(t *testing.T) {
+		t.Setenv("TEST_NEW_NAME", "   ")
+		t.Setenv("TEST_OLD_NAME", "old")
+
+		val, key, found := ParseFirstSetEnv("TEST_NEW_NAME", "TEST_OLD_NAME")
+		if !found || val != "old" || key != "TEST_OLD_NAME" {
+			t.Fatalf("got (%q, %q, %v), want (\"old\", \"TEST_OLD_NAME\", true)", val, key, found)
+		}
+	})
+
+	t.Run("none set", func(t *testing.T) {
+		t.Setenv("TEST_NEW_NAME", "")
+		t.Setenv("TEST_OLD_NAME", "")
+
+		val, key, found := ParseFirstSetEnv("TEST_NEW_NAME", "TEST_OLD_NAME")
+		if found || val != "" || key != "" {
+			t.Fatalf("got (%q, %q, %v), want (\"\", \"\", false)", val, key, found)
+		}
+	})
+
+	t.Run("no keys", func(t *testing.T) {
+		if _, _, found := ParseFirstSetEnv(); found {
+			t.Fatal("expected found=false for no keys")
+		}
+	})
+}
+
+func TestRequiredVariants(t *testing.T) {
+	t.Run("string array", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRED", " a \n\nb")
+		got, err := ParseStringArrayEnvRequired("TEST_REQUIRED")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Fatalf("got %v, want [a b]", got)
+		}
+
+		t.Setenv("TEST_REQUIRED", " \n \n")
+		_, err = ParseStringArrayEnvRequired("TEST_REQUIRED")
+		if err == nil || err.Error() != "environment variable TEST_REQUIRED is required" {
+			t.Fatalf("expected required error, got %v", err)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRED", " false ")
+		got, err := ParseBoolEnvRequired("TEST_REQUIRED")
+		if err != nil || got {
+			t.Fatalf("got (%v, %v), want (false, nil)", got, err)
+		}
+
+		t.Setenv("TEST_REQUIRED", "yes")
+		got, err = ParseBoolEnvRequired("TEST_REQUIRED")
+		if err != nil || !got {
+			t.Fatalf("got (%v, %v), want (true, nil)", got, err)
+		}
+
+		t.Setenv("TEST_REQUIRED", "maybe")
+		if _, err := ParseBoolEnvRequired("TEST_REQUIRED"); err == nil {
+			t.Fatal("expected parse error, got nil")
+		}
+
+		t.Setenv("TEST_REQUIRED", "")
+		_, err = ParseBoolEnvRequired("TEST_REQUIRED")
+		if err == nil || err.Error() != "environment variable TEST_REQUIRED is required" {
+			t.Fatalf("expected required error, got %v", err)
+		}
+	})
+
+	t.Run("uint", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRED", " 5 ")
+		got, err := ParseUintEnvRequired("TEST_REQUIRED")
+		if err != nil || got != 5 {
+			t.Fatalf("got (%d, %v), want (5, nil)", got, err)
+		}
+
+		for _, bad := range []string{"0", "-1", "abc"} {
+			t.Setenv("TEST_REQUIRED", bad)
+			_, err := ParseUintEnvRequired("TEST_REQUIRED")
+			if err == nil || !strings.Contains(err.Error(), "must be a positive integer") {
+				t.Fatalf("ParseUintEnvRequired(%q) error = %v, want positive integer error", bad, err)
+			}
+		}
+
+		t.Setenv("TEST_REQUIRED", "")
+		os.Unsetenv("TEST_REQUIRED")
+		_, err = ParseUintEnvRequired("TEST_REQUIRED")
+		if err == nil || err.Error() != "environment variable TEST_REQUIRED is required" {
+			t.Fatalf("expected required error, got %v", err)
+		}
+	})
+}
+
 func TestEnsureRepoRelativePath(t *testing.T) {
 	type tc struct {
 		name        string
@@ -260,6 +820,31 @@ func TestEnsureRepoRelativePath(t *testing.T) {
 			in:          "C:foo",
 			expectError: "drive-prefixed",
 		},
+		{
+			name: "backslashes are separators",
+			in:   `a\b\..\c`,
+			want: "a/c",
+		},
+		{
+			name: "windows-style relative path",
+			in:   `locales\en`,
+			want: "locales/en",
+		},
+		{
+			name:        "backslash parent escape forbidden",
+			in:          `..\outside`,
+			expectError: `escapes repo root: "..\\outside"`,
+		},
+		{
+			name:        "backslash UNC-like path forbidden",
+			in:          `\\server\share`,
+			expectError: `path must be relative to repo: "\\\\server\\share"`,
+		},
+		{
+			name:        "backslash drive-prefixed path forbidden",
+			in:          `C:\foo`,
+			expectError: `drive-prefixed): "C:\\foo"`,
+		},
 		{
 			name:        "glob meta * forbidden",
 			in:          "locales/*",
@@ -337,6 +922,18 @@ func TestParseRepoRelativePathsEnv(t *testing.T) {
 		}
 	})
 
+	t.Run("leading BOM is stripped", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "\ufefflocales\nassets/i18n")
+		got, err := ParseRepoRelativePathsEnv("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"locales", "assets/i18n"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
 	t.Run("single valid", func(t *testing.T) {
 		t.Setenv("TEST_PATHS", "locales")
 		got, err := ParseRepoRelativePathsEnv("TEST_PATHS")
@@ -390,6 +987,180 @@ func TestParseRepoRelativePathsEnv(t *testing.T) {
 	})
 }
 
+func TestParseRepoRelativePathsEnvFold(t *testing.T) {
+	t.Run("case variants collapse to first spelling", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "Locales\nsrc\nlocales/\n./LOCALES\nSRC/App\nsrc/app")
+		got, err := ParseRepoRelativePathsEnvFold("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"Locales", "src", "SRC/App"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("invalid path -> error", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "Locales\n../up")
+		_, err := ParseRepoRelativePathsEnvFold("TEST_PATHS")
+		if err == nil || !strings.Contains(err.Error(), "escapes repo root") {
+			t.Fatalf("expected escape error, got %v", err)
+		}
+	})
+}
+
+func TestParseRepoRelativeGlobsEnv(t *testing.T) {
+	t.Run("globs allowed, normalized and deduped order-preserving", func(t *testing.T) {
+		t.Setenv("TEST_GLOBS", "./locales/*.json\n**/*.yaml\nlocales//*.json\nfile?.yml\n[ab].yaml\nplain/dir/")
+		got, err := ParseRepoRelativeGlobsEnv("TEST_GLOBS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"locales/*.json", "**/*.yaml", "file?.yml", "[ab].yaml", "plain/dir"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("backslashes are separators", func(t *testing.T) {
+		t.Setenv("TEST_GLOBS", strings.Join([]string{`locales\*.json`, `.\./*`, `.\.\x`, `a\b\..\c\**`}, "\n"))
+		got, err := ParseRepoRelativeGlobsEnv("TEST_GLOBS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"locales/*.json", "*", "x", "a/c/**"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("required env missing -> error", func(t *testing.T) {
+		t.Setenv("TEST_GLOBS", "")
+		_, err := ParseRepoRelativeGlobsEnv("TEST_GLOBS")
+		if err == nil || !strings.Contains(err.Error(), "required") {
+			t.Fatalf("expected required error, got %v", err)
+		}
+	})
+
+	unsafe := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"parent escape glob", "../*", "escapes repo root"},
+		{"parent escape after clean", "a/../../**/*.json", "escapes repo root"},
+		{"absolute glob", "/etc/*", "relative to repo"},
+		{"UNC-like glob", "//server/share/*", "relative to repo"},
+		{"drive-prefixed glob", "C:*.json", "drive-prefixed"},
+		{"backslash parent escape glob", `..\*`, "escapes repo root"},
+		{"backslash UNC-like glob", `\\server\share\*`, "relative to repo"},
+		{"backslash drive-prefixed glob", `C:\locales\*`, "drive-prefixed"},
+		{"backslash parent escape after clean", `x\..\..\secret\*`, `escapes repo root: "x\\..\\..\\secret\\*"`},
+		{"leading backslash glob keeps input in error", `\./x`, `relative to repo: "\\./x"`},
+	}
+	for _, tt := range unsafe {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_GLOBS", "ok/*\n"+tt.in)
+			_, err := ParseRepoRelativeGlobsEnv("TEST_GLOBS")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestGroupPathsByRoot(t *testing.T) {
+	t.Run("groups by top-level dir preserving order", func(t *testing.T) {
+		val := strings.Join([]string{
+			"app/locales/en.json",
+			"web/i18n",
+			"./app/strings",
+			"README.md",
+			"web//i18n/fr",
+			"app",
+		}, "\n")
+		t.Setenv("TEST_PATHS", val)
+
+		got, err := GroupPathsByRoot("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string][]string{
+			"app":       {"app/locales/en.json", "app/strings", "app"},
+			"web":       {"web/i18n", "web/i18n/fr"},
+			"README.md": {"README.md"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("single-segment paths use their own name", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "locales\ni18n\n.")
+
+		got, err := GroupPathsByRoot("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string][]string{
+			"locales": {"locales"},
+			"i18n":    {"i18n"},
+			".":       {"."},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("backslashes are separators", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", `app\locales\en.json`+"\napp/strings")
+
+		got, err := GroupPathsByRoot("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := map[string][]string{
+			"app": {"app/locales/en.json", "app/strings"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	unsafe := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"absolute path", "/etc/passwd", "relative to repo"},
+		{"parent escape", "../secret/x", "escapes repo root"},
+		{"backslash parent escape", `..\secret\x`, "escapes repo root"},
+		{"drive-prefixed path", "C:/Windows/x", "drive-prefixed"},
+	}
+	for _, tt := range unsafe {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_PATHS", "app/ok\n"+tt.in)
+
+			got, err := GroupPathsByRoot("TEST_PATHS")
+			if err == nil || !strings.Contains(err.Error(), tt.want) || !strings.Contains(err.Error(), "TEST_PATHS") {
+				t.Fatalf("expected error containing %q, got %v (groups %v)", tt.want, err, got)
+			}
+		})
+	}
+
+	t.Run("empty env returns empty map", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "")
+
+		got, err := GroupPathsByRoot("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %v, want empty map", got)
+		}
+	})
+}
+
 func TestEnsureRepoRelativePattern(t *testing.T) {
 	type tc struct {
 		name        string
@@ -608,6 +1379,76 @@ func TestParseObject_InvalidCases(t *testing.T) {
 	}
 }
 
+func TestParseJSONArrayEnv(t *testing.T) {
+	type batch struct {
+		Name  string   `json:"name"`
+		Files []string `json:"files"`
+		Limit int      `json:"limit"`
+	}
+
+	t.Run("decodes struct slice in order", func(t *testing.T) {
+		t.Setenv("TEST_BATCHES", `[
+			{"name": "web", "files": ["a.json"], "limit": 2},
+			{"name": "mobile", "files": ["b.json", "c.json"]}
+		]`)
+
+		got, err := ParseJSONArrayEnv[batch]("TEST_BATCHES")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []batch{
+			{Name: "web", Files: []string{"a.json"}, Limit: 2},
+			{Name: "mobile", Files: []string{"b.json", "c.json"}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("unset returns empty slice", func(t *testing.T) {
+		t.Setenv("TEST_BATCHES", "")
+
+		got, err := ParseJSONArrayEnv[batch]("TEST_BATCHES")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %#v, want empty slice", got)
+		}
+	})
+
+	t.Run("null returns empty slice", func(t *testing.T) {
+		t.Setenv("TEST_BATCHES", "null")
+
+		got, err := ParseJSONArrayEnv[batch]("TEST_BATCHES")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("got %#v, want empty slice", got)
+		}
+	})
+
+	invalid := []struct {
+		name string
+		raw  string
+	}{
+		{"Malformed JSON", `[{"name": "web"`},
+		{"Object instead of array", `{"name": "web"}`},
+		{"Wrong element type", `[{"limit": "two"}]`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_BATCHES", tt.raw)
+
+			_, err := ParseJSONArrayEnv[batch]("TEST_BATCHES")
+			if err == nil || !strings.Contains(err.Error(), "TEST_BATCHES") {
+				t.Fatalf("expected decode error naming TEST_BATCHES, got %v", err)
+			}
+		})
+	}
+}
+
 func TestParseAdditionalParamsAndMerge_JSON_Overrides(t *testing.T) {
 	type Params map[string]any
 
@@ -0,0 +1,222 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PathKind describes what a path points to on disk.
+type PathKind int
+
+const (
+	// PathMissing means nothing exists at the path.
+	PathMissing PathKind = iota
+	// PathFile means the path is a regular (or other non-directory) file.
+	PathFile
+	// PathDir means the path is a directory.
+	PathDir
+	// PathSymlink means the path is a symbolic link; it is not followed.
+	PathSymlink
+)
+
+// String returns a human-readable name of the path kind.
+func (k PathKind) String() string {
+	switch k {
+	case PathMissing:
+		return "missing"
+	case PathFile:
+		return "file"
+	case PathDir:
+		return "dir"
+	case PathSymlink:
+		return "symlink"
+	default:
+		return fmt.Sprintf("PathKind(%d)", int(k))
+	}
+}
+
+// PathInfo is a repo-relative path together with its kind on disk.
+type PathInfo struct {
+	Path string
+	Kind PathKind
+}
+
+// ParseMergedPathsEnv reads two multiline path lists (e.g. BASE_PATHS and EXTRA_PATHS),
+// validates every entry with EnsureRepoRelativePath, and returns base entries followed
+// by extra entries, normalized to forward slashes and deduplicated (order-preserving).
+// Either variable may be unset; if both are, the result is empty.
+func ParseMergedPathsEnv(baseKey, extraKey string) ([]string, error) {
+	base := ParseStringArrayEnv(baseKey)
+	extra := ParseStringArrayEnv(extraKey)
+
+	seen := make(map[string]struct{}, len(base)+len(extra))
+	out, err := appendRepoRelativePaths(make([]string, 0, len(base)+len(extra)), seen, baseKey, base, EnsureRepoRelativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendRepoRelativePaths(out, seen, extraKey, extra, EnsureRepoRelativePath)
+}
+
+// ValidateDisjointPaths normalizes both lists with EnsureRepoRelativePath and
+// returns an error naming every path that appears in both (e.g. INCLUDE_PATHS
+// and EXCLUDE_PATHS). Normalization means "./x" and "x" are treated as the same path.
+// Returns an error if any entry is not a valid repo-relative path.
+func ValidateDisjointPaths(a, b []string) error {
+	normA, err := normalizeRepoRelativePathList(a)
+	if err != nil {
+		return err
+	}
+	normB, err := normalizeRepoRelativePathList(b)
+	if err != nil {
+		return err
+	}
+
+	inB := make(map[string]struct{}, len(normB))
+	for _, p := range normB {
+		inB[p] = struct{}{}
+	}
+
+	var overlap []string
+	reported := make(map[string]struct{})
+	for _, p := range normA {
+		if _, ok := inB[p]; !ok {
+			continue
+		}
+		if _, dup := reported[p]; dup {
+			continue
+		}
+		reported[p] = struct{}{}
+		overlap = append(overlap, p)
+	}
+
+	if len(overlap) > 0 {
+		return fmt.Errorf("paths must not appear in both lists: %s", strings.Join(overlap, ", "))
+	}
+	return nil
+}
+
+// ValidateNoPathPrefixOverlap normalizes paths with EnsureRepoRelativePath and
+// returns an error naming the first pair where one path is an ancestor of another
+// (e.g. "a" and "a/b", or "." and anything). Identical entries, including ones that
+// only differ before normalization ("./a" and "a"), are treated as duplicates, not overlaps.
+// Returns an error if any entry is not a valid repo-relative path.
+func ValidateNoPathPrefixOverlap(paths []string) error {
+	norm, err := normalizeRepoRelativePathList(paths)
+	if err != nil {
+		return err
+	}
+
+	for i, a := range norm {
+		for _, b := range norm[i+1:] {
+			if a == b {
+				continue
+			}
+			if isAncestorPath(a, b) {
+				return fmt.Errorf("overlapping paths: %q is a parent of %q", a, b)
+			}
+			if isAncestorPath(b, a) {
+				return fmt.Errorf("overlapping paths: %q is a parent of %q", b, a)
+			}
+		}
+	}
+
+	return nil
+}
+
+// EnsureRepoRelativePathWithin validates path with EnsureRepoRelativePath and
+// checks that it equals base or lies under it. Both are repo-relative; base is
+// validated with the same rules (use "." for the repo root).
+// Returns the cleaned path relative to the repo root (OS-native separators), e.g.
+// base "i18n" and path "i18n/./en/../fr" => "i18n/fr", while "docs" is rejected.
+func EnsureRepoRelativePathWithin(base, path string) (string, error) {
+	cleanBase, err := EnsureRepoRelativePath(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid base %q: %w", base, err)
+	}
+
+	clean, err := EnsureRepoRelativePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	b, p := filepath.ToSlash(cleanBase), filepath.ToSlash(clean)
+	if p != b && !isAncestorPath(b, p) {
+		return "", fmt.Errorf("path %q escapes base %q", path, base)
+	}
+
+	return clean, nil
+}
+
+// isAncestorPath reports whether parent strictly contains child.
+// Both must be cleaned, forward-slash, repo-relative paths.
+func isAncestorPath(parent, child string) bool {
+	if parent == child {
+		return false
+	}
+	if parent == "." {
+		return true
+	}
+	return strings.HasPrefix(child, parent+"/")
+}
+
+// normalizeRepoRelativePathList validates each entry with EnsureRepoRelativePath
+// and returns the cleaned paths with forward slashes, in input order.
+func normalizeRepoRelativePathList(paths []string) ([]string, error) {
+	out := make([]string, 0, len(paths))
+
+	for _, p := range paths {
+		clean, err := EnsureRepoRelativePath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %w", p, err)
+		}
+		out = append(out, filepath.ToSlash(clean))
+	}
+
+	return out, nil
+}
+
+// ClassifyPaths reads paths with ParseRepoRelativePathsEnv and reports whether
+// each one is a file, directory, symlink, or missing, relative to the current
+// working directory. Symlinks are reported as such and not followed.
+// Returns an error if the env var is empty, any entry is unsafe, or a path cannot be inspected.
+func ClassifyPaths(envVar string) ([]PathInfo, error) {
+	paths, err := ParseRepoRelativePathsEnv(envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]PathInfo, 0, len(paths))
+	for _, p := range paths {
+		kind, err := classifyPath(p)
+		if err != nil {
+			return nil, fmt.Errorf("inspect path %q from %s: %w", p, envVar, err)
+		}
+		out = append(out, PathInfo{Path: p, Kind: kind})
+	}
+
+	return out, nil
+}
+
+func classifyPath(p string) (PathKind, error) {
+	info, err := os.Lstat(filepath.FromSlash(p))
+	if errors.Is(err, fs.ErrNotExist) {
+		return PathMissing, nil
+	}
+	if err != nil {
+		return PathMissing, err
+	}
+
+	switch {
+	case info.Mode()&fs.ModeSymlink != 0:
+		return PathSymlink, nil
+	case info.IsDir():
+		return PathDir, nil
+	default:
+		return PathFile, nil
+	}
+}
@@ -0,0 +1,219 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMergedPathsEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		extra   string
+		want    []string
+		wantErr string
+	}{
+		{name: "base only", base: "locales\n./i18n", want: []string{"locales", "i18n"}},
+		{name: "extra only", extra: "vendor/i18n", want: []string{"vendor/i18n"}},
+		{name: "both with overlap", base: "locales\ni18n", extra: "./i18n/\nextra\nlocales", want: []string{"locales", "i18n", "extra"}},
+		{name: "both empty", want: []string{}},
+		{name: "invalid base entry", base: "../up", wantErr: "in TEST_BASE_PATHS"},
+		{name: "invalid extra entry", base: "locales", extra: "/abs", wantErr: "in TEST_EXTRA_PATHS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_BASE_PATHS", tt.base)
+			t.Setenv("TEST_EXTRA_PATHS", tt.extra)
+
+			got, err := ParseMergedPathsEnv("TEST_BASE_PATHS", "TEST_EXTRA_PATHS")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDisjointPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       []string
+		b       []string
+		wantErr string
+	}{
+		{name: "fully disjoint", a: []string{"locales", "i18n/app"}, b: []string{"vendor", "i18n/lib"}},
+		{name: "empty lists", a: nil, b: nil},
+		{name: "parent and child are not identical", a: []string{"locales"}, b: []string{"locales/en"}},
+		{name: "overlapping entry", a: []string{"locales", "i18n"}, b: []string{"vendor", "i18n"}, wantErr: "i18n"},
+		{name: "normalization-induced overlap", a: []string{"./x"}, b: []string{"x/"}, wantErr: "both lists: x"},
+		{name: "several overlaps are all named", a: []string{"a", "b", "a"}, b: []string{"b", "a"}, wantErr: "both lists: a, b"},
+		{name: "invalid entry", a: []string{"../up"}, b: []string{"x"}, wantErr: "escapes repo root"},
+		{name: "invalid entry in second list", a: []string{"x"}, b: []string{"y/*"}, wantErr: "glob characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDisjointPaths(tt.a, tt.b)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateNoPathPrefixOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		wantErr string
+	}{
+		{name: "non-overlapping", paths: []string{"a", "ab", "b/c", "b/d"}},
+		{name: "empty", paths: nil},
+		{name: "identical entries", paths: []string{"a/b", "./a/b", "a/b/"}},
+		{name: "parent before child", paths: []string{"a", "x", "a/b"}, wantErr: `"a" is a parent of "a/b"`},
+		{name: "child before parent", paths: []string{"a/b/c", "a"}, wantErr: `"a" is a parent of "a/b/c"`},
+		{name: "repo root overlaps everything", paths: []string{"locales", "."}, wantErr: `"." is a parent of "locales"`},
+		{name: "overlap after normalization", paths: []string{"./a/", "a//b"}, wantErr: `"a" is a parent of "a/b"`},
+		{name: "invalid entry", paths: []string{"a", "../b"}, wantErr: "escapes repo root"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNoPathPrefixOverlap(tt.paths)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEnsureRepoRelativePathWithin(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{name: "child of base", base: "i18n", path: "i18n/en.json", want: "i18n/en.json"},
+		{name: "cleaned inside base", base: "i18n/", path: "./i18n/en/../fr", want: "i18n/fr"},
+		{name: "equal to base", base: "i18n", path: "i18n/", want: "i18n"},
+		{name: "repo root base", base: ".", path: "docs/a.md", want: "docs/a.md"},
+		{name: "sibling is rejected", base: "i18n", path: "docs/a.md", wantErr: "escapes base"},
+		{name: "shared name prefix is rejected", base: "i18n", path: "i18n-old/en.json", wantErr: "escapes base"},
+		{name: "escape via parent is rejected", base: "i18n", path: "i18n/../docs", wantErr: "escapes base"},
+		{name: "root path outside base", base: "i18n", path: ".", wantErr: "escapes base"},
+		{name: "invalid path", base: "i18n", path: "../i18n/en", wantErr: "escapes repo root"},
+		{name: "invalid base", base: "/abs", path: "i18n/en", wantErr: `invalid base "/abs"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EnsureRepoRelativePathWithin(tt.base, tt.path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if filepath.ToSlash(got) != tt.want {
+				t.Fatalf("got %q, want %q", filepath.ToSlash(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyPaths(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "locales"), 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "locales", "en.json"), []byte("{}"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	hasSymlink := os.Symlink("locales", filepath.Join(root, "link")) == nil
+	t.Chdir(root)
+
+	t.Run("file, dir, symlink and missing", func(t *testing.T) {
+		lines := []string{"locales/en.json", "./locales", "missing/path"}
+		want := []PathInfo{
+			{Path: "locales/en.json", Kind: PathFile},
+			{Path: "locales", Kind: PathDir},
+			{Path: "missing/path", Kind: PathMissing},
+		}
+		if hasSymlink {
+			lines = append(lines, "link")
+			want = append(want, PathInfo{Path: "link", Kind: PathSymlink})
+		}
+		t.Setenv("TEST_PATHS", strings.Join(lines, "\n"))
+
+		got, err := ClassifyPaths("TEST_PATHS")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unsafe path errors", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "locales\n../outside")
+
+		_, err := ClassifyPaths("TEST_PATHS")
+		if err == nil || !strings.Contains(err.Error(), "escapes repo root") {
+			t.Fatalf("expected escape error, got %v", err)
+		}
+	})
+
+	t.Run("empty env errors", func(t *testing.T) {
+		t.Setenv("TEST_PATHS", "")
+
+		_, err := ClassifyPaths("TEST_PATHS")
+		if err == nil || !strings.Contains(err.Error(), "required") {
+			t.Fatalf("expected required error, got %v", err)
+		}
+	})
+}
+
+func TestPathKind_String(t *testing.T) {
+	tests := map[PathKind]string{
+		PathMissing:  "missing",
+		PathFile:     "file",
+		PathDir:      "dir",
+		PathSymlink:  "symlink",
+		PathKind(99): "PathKind(99)",
+	}
+
+	for kind, want := range tests {
+		if got := kind.String(); got != want {
+			t.Fatalf("PathKind(%d).String() = %q, want %q", int(kind), got, want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ParseRegexpEnv compiles the trimmed value of an environment variable as a regular expression.
+// Returns nil and no error if the variable is not set or empty,
+// so callers can treat a nil result as "match everything".
+// Returns an error if the pattern does not compile.
+func ParseRegexpEnv(envVar string) (*regexp.Regexp, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression in %s: %w", envVar, err)
+	}
+	return re, nil
+}
+
+// ParseValidatedEnv returns the trimmed value of a required environment variable
+// if it matches pattern (e.g. `^[A-Za-z0-9]{8,}$`). Anchor the pattern to validate
+// the whole value; unanchored patterns match substrings.
+// Returns an error if pattern does not compile, the variable is unset, or the value does not match.
+func ParseValidatedEnv(envVar, pattern string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid validation pattern for %s: %w", envVar, err)
+	}
+
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return "", fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	if !re.MatchString(val) {
+		return "", fmt.Errorf("%s value %q does not match pattern %q", envVar, val, pattern)
+	}
+	return val, nil
+}
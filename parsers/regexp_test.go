@@ -0,0 +1,85 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRegexpEnv(t *testing.T) {
+	t.Run("valid pattern", func(t *testing.T) {
+		t.Setenv("TEST_INCLUDE_RE", " ^locales/ ")
+
+		re, err := ParseRegexpEnv("TEST_INCLUDE_RE")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if re == nil {
+			t.Fatal("expected compiled regexp, got nil")
+		}
+		if !re.MatchString("locales/en.json") || re.MatchString("src/locales/en.json") {
+			t.Fatalf("unexpected matching behavior for %q", re.String())
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		t.Setenv("TEST_INCLUDE_RE", "locales/(")
+
+		re, err := ParseRegexpEnv("TEST_INCLUDE_RE")
+		if err == nil || !strings.Contains(err.Error(), "TEST_INCLUDE_RE") {
+			t.Fatalf("expected error naming TEST_INCLUDE_RE, got %v", err)
+		}
+		if re != nil {
+			t.Fatalf("expected nil regexp on error, got %v", re)
+		}
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Setenv("TEST_INCLUDE_RE", "")
+
+		re, err := ParseRegexpEnv("TEST_INCLUDE_RE")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if re != nil {
+			t.Fatalf("expected nil regexp, got %v", re)
+		}
+	})
+}
+
+func TestParseValidatedEnv(t *testing.T) {
+	const tokenPattern = `^[A-Za-z0-9]{8,}$`
+
+	tests := []struct {
+		name     string
+		envValue string
+		pattern  string
+		want     string
+		wantErr  string
+	}{
+		{name: "matching value", envValue: " abcd1234XY ", pattern: tokenPattern, want: "abcd1234XY"},
+		{name: "non-matching value", envValue: "short", pattern: tokenPattern, wantErr: `TEST_TOKEN value "short" does not match pattern`},
+		{name: "invalid characters", envValue: "abcd-1234", pattern: tokenPattern, wantErr: "does not match"},
+		{name: "bad pattern", envValue: "abcd1234", pattern: `^[a-z`, wantErr: "invalid validation pattern"},
+		{name: "unset", envValue: "", pattern: tokenPattern, wantErr: "required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_TOKEN", tt.envValue)
+
+			got, err := ParseValidatedEnv("TEST_TOKEN", tt.pattern)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults used by ParseRetryPolicyEnv for unset fields.
+const (
+	DefaultRetryMax      = 3
+	DefaultRetryBase     = time.Second
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// RetryPolicy describes how many times to retry and how long to back off.
+type RetryPolicy struct {
+	// Max is the maximum number of retries; 0 disables retrying.
+	Max int
+	// Base is the initial backoff delay.
+	Base time.Duration
+	// MaxDelay caps the backoff delay.
+	MaxDelay time.Duration
+}
+
+// ParseRetryPolicyEnv reads a retry policy from <prefix>MAX (non-negative integer),
+// <prefix>BASE and <prefix>MAX_DELAY (durations such as "500ms" or "1m"), e.g.
+// prefix "RETRY_" reads RETRY_MAX, RETRY_BASE and RETRY_MAX_DELAY.
+// Unset or empty fields get DefaultRetryMax, DefaultRetryBase and DefaultRetryMaxDelay.
+// Returns an error naming the variable if a value is invalid or negative,
+// or if the resulting max delay is less than the base delay.
+func ParseRetryPolicyEnv(prefix string) (RetryPolicy, error) {
+	p := RetryPolicy{Max: DefaultRetryMax, Base: DefaultRetryBase, MaxDelay: DefaultRetryMaxDelay}
+
+	maxKey, baseKey, maxDelayKey := prefix+"MAX", prefix+"BASE", prefix+"MAX_DELAY"
+
+	if val := strings.TrimSpace(os.Getenv(maxKey)); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return RetryPolicy{}, fmt.Errorf("invalid value for %s: %q (expected a non-negative integer)", maxKey, val)
+		}
+		p.Max = n
+	}
+
+	var err error
+	if p.Base, err = parseRetryDuration(baseKey, p.Base); err != nil {
+		return RetryPolicy{}, err
+	}
+	if p.MaxDelay, err = parseRetryDuration(maxDelayKey, p.MaxDelay); err != nil {
+		return RetryPolicy{}, err
+	}
+
+	if p.MaxDelay < p.Base {
+		return RetryPolicy{}, fmt.Errorf("%s (%s) must not be less than %s (%s)", maxDelayKey, p.MaxDelay, baseKey, p.Base)
+	}
+
+	return p, nil
+}
+
+// parseRetryDuration parses a non-negative duration from envVar, returning defaultVal if unset.
+func parseRetryDuration(envVar string, defaultVal time.Duration) (time.Duration, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration for %s: %w", envVar, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid duration for %s: %q must not be negative", envVar, val)
+	}
+	return d, nil
+}
@@ -0,0 +1,77 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRetryPolicyEnv(t *testing.T) {
+	set := func(t *testing.T, maxVal, base, maxDelay string) {
+		t.Helper()
+		t.Setenv("TEST_RETRY_MAX", maxVal)
+		t.Setenv("TEST_RETRY_BASE", base)
+		t.Setenv("TEST_RETRY_MAX_DELAY", maxDelay)
+	}
+
+	tests := []struct {
+		name                   string
+		maxVal, base, maxDelay string
+		want                   RetryPolicy
+	}{
+		{
+			name: "all set", maxVal: "5", base: "200ms", maxDelay: "10s",
+			want: RetryPolicy{Max: 5, Base: 200 * time.Millisecond, MaxDelay: 10 * time.Second},
+		},
+		{
+			name: "all unset uses defaults",
+			want: RetryPolicy{Max: DefaultRetryMax, Base: DefaultRetryBase, MaxDelay: DefaultRetryMaxDelay},
+		},
+		{
+			name: "partial uses defaults for the rest", maxVal: " 0 ", base: "2s",
+			want: RetryPolicy{Max: 0, Base: 2 * time.Second, MaxDelay: DefaultRetryMaxDelay},
+		},
+		{
+			name: "equal base and max delay", base: "5s", maxDelay: "5s",
+			want: RetryPolicy{Max: DefaultRetryMax, Base: 5 * time.Second, MaxDelay: 5 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set(t, tt.maxVal, tt.base, tt.maxDelay)
+
+			got, err := ParseRetryPolicyEnv("TEST_RETRY_")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	errCases := []struct {
+		name                   string
+		maxVal, base, maxDelay string
+		want                   string
+	}{
+		{name: "max delay less than base", base: "10s", maxDelay: "1s", want: "TEST_RETRY_MAX_DELAY (1s) must not be less than TEST_RETRY_BASE (10s)"},
+		{name: "base above default max delay", base: "1m", want: "must not be less than"},
+		{name: "invalid max", maxVal: "many", want: "invalid value for TEST_RETRY_MAX"},
+		{name: "negative max", maxVal: "-1", want: "invalid value for TEST_RETRY_MAX"},
+		{name: "invalid base", base: "soon", want: "invalid duration for TEST_RETRY_BASE"},
+		{name: "negative max delay", maxDelay: "-5s", want: "invalid duration for TEST_RETRY_MAX_DELAY"},
+	}
+
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			set(t, tc.maxVal, tc.base, tc.maxDelay)
+
+			_, err := ParseRetryPolicyEnv("TEST_RETRY_")
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ParseSemverEnv parses a required version of the form v?MAJOR.MINOR.PATCH[-pre],
+// e.g. "1.2.3", "v2.0.0" or "1.2.3-rc.1". The leading "v" is optional and stripped.
+// Numeric parts must be decimal without leading zeros. The prerelease, if present,
+// is a non-empty list of dot-separated [0-9A-Za-z-] identifiers and is returned as is.
+// Build metadata ("+build") is not supported.
+// Returns an error if the variable is unset or the value is malformed.
+func ParseSemverEnv(envVar string) (major, minor, patch int, pre string, err error) {
+	raw := strings.TrimSpace(os.Getenv(envVar))
+	if raw == "" {
+		return 0, 0, 0, "", fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	core, pre, hasPre := strings.Cut(strings.TrimPrefix(raw, "v"), "-")
+	if hasPre && !isSemverPrerelease(pre) {
+		return 0, 0, 0, "", fmt.Errorf("invalid version in %s: %q: malformed prerelease %q", envVar, raw, pre)
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return 0, 0, 0, "", fmt.Errorf("invalid version in %s: %q: expected MAJOR.MINOR.PATCH", envVar, raw)
+	}
+
+	var nums [3]int
+	for i, p := range parts {
+		if p == "" || !isASCIIDigits(p) || (len(p) > 1 && p[0] == '0') {
+			return 0, 0, 0, "", fmt.Errorf("invalid version in %s: %q: bad number %q", envVar, raw, p)
+		}
+		n, convErr := strconv.Atoi(p)
+		if convErr != nil {
+			return 0, 0, 0, "", fmt.Errorf("invalid version in %s: %q: %w", envVar, raw, convErr)
+		}
+		nums[i] = n
+	}
+
+	return nums[0], nums[1], nums[2], pre, nil
+}
+
+// isSemverPrerelease reports whether s is a non-empty list of dot-separated
+// identifiers made of ASCII letters, digits and hyphens.
+func isSemverPrerelease(s string) bool {
+	if s == "" {
+		return false
+	}
+	for id := range strings.SplitSeq(s, ".") {
+		if id == "" {
+			return false
+		}
+		for i := 0; i < len(id); i++ {
+			c := id[i]
+			if (c < '0' || c > '9') && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && c != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package parsers
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseSemverEnv(t *testing.T) {
+	tests := []struct {
+		name                string
+		envValue            string
+		major, minor, patch int
+		pre                 string
+	}{
+		{name: "plain", envValue: "1.2.3", major: 1, minor: 2, patch: 3},
+		{name: "leading v", envValue: " v10.0.7 ", major: 10, patch: 7},
+		{name: "zeros", envValue: "0.0.0", major: 0},
+		{name: "prerelease", envValue: "1.2.3-rc.1", major: 1, minor: 2, patch: 3, pre: "rc.1"},
+		{name: "prerelease with hyphen", envValue: "v2.0.0-beta-2", major: 2, pre: "beta-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_SEMVER", tt.envValue)
+
+			major, minor, patch, pre, err := ParseSemverEnv("TEST_SEMVER")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if major != tt.major || minor != tt.minor || patch != tt.patch || pre != tt.pre {
+				t.Fatalf("got %d.%d.%d-%q, want %d.%d.%d-%q", major, minor, patch, pre, tt.major, tt.minor, tt.patch, tt.pre)
+			}
+		})
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		t.Setenv("TEST_SEMVER", "")
+		os.Unsetenv("TEST_SEMVER")
+
+		_, _, _, _, err := ParseSemverEnv("TEST_SEMVER")
+		if err == nil || !strings.Contains(err.Error(), "TEST_SEMVER is required") {
+			t.Fatalf("expected required error, got %v", err)
+		}
+	})
+
+	errCases := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{"two parts", "1.2", "expected MAJOR.MINOR.PATCH"},
+		{"four parts", "1.2.3.4", "expected MAJOR.MINOR.PATCH"},
+		{"empty part", "1..3", `bad number ""`},
+		{"non-numeric", "1.x.3", `bad number "x"`},
+		{"leading zero", "1.02.3", `bad number "02"`},
+		{"negative", "-1.2.3", "expected MAJOR.MINOR.PATCH"},
+		{"empty prerelease", "1.2.3-", "malformed prerelease"},
+		{"empty prerelease identifier", "1.2.3-rc..1", "malformed prerelease"},
+		{"build metadata", "1.2.3+build", `bad number "3+build"`},
+		{"double v", "vv1.2.3", `bad number "v1"`},
+		{"overflow", "99999999999999999999.0.0", "invalid version in TEST_SEMVER"},
+	}
+
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("TEST_SEMVER", tc.envValue)
+
+			_, _, _, _, err := ParseSemverEnv("TEST_SEMVER")
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// ParseTemplateEnv parses a required environment variable as a text/template
+// (e.g. "{{.Tag}}-{{.SHA}}") and renders it against data.
+// Missing map keys are reported as errors instead of rendering "<no value>".
+// Returns an error if the variable is unset, the template is malformed, or execution fails.
+func ParseTemplateEnv(envVar string, data any) (string, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return "", fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	tmpl, err := template.New(envVar).Option("missingkey=error").Parse(val)
+	if err != nil {
+		return "", fmt.Errorf("invalid template in %s: %w", envVar, err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("render template from %s: %w", envVar, err)
+	}
+	return b.String(), nil
+}
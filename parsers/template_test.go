@@ -0,0 +1,49 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTemplateEnv(t *testing.T) {
+	type release struct {
+		Tag string
+		SHA string
+	}
+
+	tests := []struct {
+		name     string
+		envValue string
+		data     any
+		want     string
+		wantErr  string
+	}{
+		{name: "struct data", envValue: "{{.Tag}}-{{.SHA}}", data: release{Tag: "v1.2.0", SHA: "abc123"}, want: "v1.2.0-abc123"},
+		{name: "map data", envValue: " release-{{.tag}} ", data: map[string]string{"tag": "v2"}, want: "release-v2"},
+		{name: "plain text", envValue: "static", data: nil, want: "static"},
+		{name: "missing struct field", envValue: "{{.Branch}}", data: release{}, wantErr: "render template from TEST_RELEASE_NAME"},
+		{name: "missing map key", envValue: "{{.branch}}", data: map[string]string{"tag": "v2"}, wantErr: "render template"},
+		{name: "malformed syntax", envValue: "{{.Tag", data: release{}, wantErr: "invalid template in TEST_RELEASE_NAME"},
+		{name: "unset", envValue: "", data: release{}, wantErr: "required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_RELEASE_NAME", tt.envValue)
+
+			got, err := ParseTemplateEnv("TEST_RELEASE_NAME", tt.data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package parsers
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTimeOfDayEnv parses a required 24-hour time of day in "HH:MM" form.
+// "HH:MM:SS" is also accepted; seconds are validated and then ignored.
+// Single-digit hours ("9:05") are allowed, minutes and seconds must have two digits.
+//
+// Returns an error if the variable is unset, malformed, or out of range.
+func ParseTimeOfDayEnv(envVar string) (hour, minute int, err error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return 0, 0, fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	parts := strings.Split(val, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return 0, 0, fmt.Errorf("invalid time for %s: %q (expected HH:MM or HH:MM:SS)", envVar, val)
+	}
+
+	hour, err = parseTimeComponent(parts[0], 1, 23)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid hour for %s: %q: %w", envVar, val, err)
+	}
+
+	minute, err = parseTimeComponent(parts[1], 2, 59)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid minute for %s: %q: %w", envVar, val, err)
+	}
+
+	if len(parts) == 3 {
+		if _, err := parseTimeComponent(parts[2], 2, 59); err != nil {
+			return 0, 0, fmt.Errorf("invalid second for %s: %q: %w", envVar, val, err)
+		}
+	}
+
+	return hour, minute, nil
+}
+
+// ParseDurationEnv parses a duration environment variable such as "30s", "5m" or "1h30m"
+// using time.ParseDuration.
+// Returns the default value if the variable is not set, empty, invalid, or negative.
+func ParseDurationEnv(envVar string, defaultVal time.Duration) time.Duration {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d < 0 {
+		return defaultVal
+	}
+	return d
+}
+
+// ParseDurationEnvMax parses a duration environment variable like ParseDurationEnv
+// and caps it at maxVal. Values above maxVal are clamped to maxVal rather than
+// rejected, so an absurd "99h" still yields a usable timeout; use
+// ParseDurationEnvMaxStrict to reject such values instead.
+//
+// Returns defaultVal if the variable is not set or empty.
+// Returns an error if the value is invalid or negative, or if maxVal is negative.
+func ParseDurationEnvMax(envVar string, defaultVal, maxVal time.Duration) (time.Duration, error) {
+	return parseDurationEnvMax(envVar, defaultVal, maxVal, false)
+}
+
+// ParseDurationEnvMaxStrict works like ParseDurationEnvMax but returns an error
+// instead of clamping when the value is above maxVal.
+func ParseDurationEnvMaxStrict(envVar string, defaultVal, maxVal time.Duration) (time.Duration, error) {
+	return parseDurationEnvMax(envVar, defaultVal, maxVal, true)
+}
+
+// parseDurationEnvMax implements ParseDurationEnvMax and ParseDurationEnvMaxStrict.
+func parseDurationEnvMax(envVar string, defaultVal, maxVal time.Duration, strict bool) (time.Duration, error) {
+	if maxVal < 0 {
+		return 0, fmt.Errorf("max duration for %s must not be negative, got %v", envVar, maxVal)
+	}
+
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration for %s: %w", envVar, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration for %s must not be negative: %q", envVar, val)
+	}
+	if d > maxVal {
+		if strict {
+			return 0, fmt.Errorf("duration for %s exceeds maximum %v: %q", envVar, maxVal, val)
+		}
+		return maxVal, nil
+	}
+
+	return d, nil
+}
+
+// ParseDurationEnvUnit parses a duration environment variable where a bare
+// integer is interpreted in defaultUnit (e.g. "300" with time.Second => 5m),
+// while suffixed values such as "5m" or "1h30m" go through time.ParseDuration.
+//
+// Returns defaultVal if the variable is not set or empty.
+// Returns an error if the value is invalid, negative, overflows, or defaultUnit is not positive.
+func ParseDurationEnvUnit(envVar string, defaultUnit, defaultVal time.Duration) (time.Duration, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	if n, err := strconv.ParseInt(val, 10, 64); err == nil {
+		if defaultUnit <= 0 {
+			return 0, fmt.Errorf("default unit for %s must be positive, got %v", envVar, defaultUnit)
+		}
+		if n < 0 {
+			return 0, fmt.Errorf("duration for %s must not be negative: %q", envVar, val)
+		}
+		if n > int64(math.MaxInt64/defaultUnit) {
+			return 0, fmt.Errorf("duration for %s overflows: %q", envVar, val)
+		}
+		return time.Duration(n) * defaultUnit, nil
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration for %s: %w", envVar, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration for %s must not be negative: %q", envVar, val)
+	}
+	return d, nil
+}
+
+// parseTimeComponent parses a one or two digit component in [0, maxVal].
+// minDigits is the minimal number of digits required.
+func parseTimeComponent(s string, minDigits, maxVal int) (int, error) {
+	if len(s) < minDigits || len(s) > 2 {
+		return 0, fmt.Errorf("expected %d to 2 digits, got %q", minDigits, s)
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("not a number: %q", s)
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n > maxVal {
+		return 0, fmt.Errorf("value %d out of range [0-%d]", n, maxVal)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,190 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTimeOfDayEnv(t *testing.T) {
+	tests := []struct {
+		name       string
+		envValue   string
+		wantHour   int
+		wantMinute int
+		wantErr    string
+	}{
+		{name: "HH:MM", envValue: "14:30", wantHour: 14, wantMinute: 30},
+		{name: "midnight", envValue: "00:00", wantHour: 0, wantMinute: 0},
+		{name: "end of day", envValue: "23:59", wantHour: 23, wantMinute: 59},
+		{name: "single-digit hour", envValue: "9:05", wantHour: 9, wantMinute: 5},
+		{name: "trimmed", envValue: "  07:15 \n", wantHour: 7, wantMinute: 15},
+		{name: "HH:MM:SS", envValue: "14:30:59", wantHour: 14, wantMinute: 30},
+		{name: "unset", envValue: "", wantErr: "required"},
+		{name: "hour out of range", envValue: "24:00", wantErr: "invalid hour"},
+		{name: "minute out of range", envValue: "12:60", wantErr: "invalid minute"},
+		{name: "second out of range", envValue: "12:00:60", wantErr: "invalid second"},
+		{name: "missing minutes", envValue: "14", wantErr: "expected HH:MM"},
+		{name: "too many parts", envValue: "1:2:3:4", wantErr: "expected HH:MM"},
+		{name: "single-digit minute", envValue: "14:5", wantErr: "invalid minute"},
+		{name: "non-numeric", envValue: "ab:cd", wantErr: "invalid hour"},
+		{name: "signed value", envValue: "+1:00", wantErr: "invalid hour"},
+		{name: "empty hour", envValue: ":30", wantErr: "invalid hour"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_RUN_AT", tt.envValue)
+
+			hour, minute, err := ParseTimeOfDayEnv("TEST_RUN_AT")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hour != tt.wantHour || minute != tt.wantMinute {
+				t.Fatalf("got %02d:%02d, want %02d:%02d", hour, minute, tt.wantHour, tt.wantMinute)
+			}
+		})
+	}
+}
+
+func TestParseDurationEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected time.Duration
+	}{
+		{"Empty value", "", time.Minute},
+		{"Whitespace input", "  ", time.Minute},
+		{"Seconds", "30s", 30 * time.Second},
+		{"Minutes", "5m", 5 * time.Minute},
+		{"Compound", "1h30m", 90 * time.Minute},
+		{"Trimmed", " 250ms \n", 250 * time.Millisecond},
+		{"Zero", "0", 0},
+		{"Negative", "-5s", time.Minute},
+		{"Missing unit", "30", time.Minute},
+		{"Invalid", "soon", time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_TIMEOUT", tt.envValue)
+
+			if got := ParseDurationEnv("TEST_TIMEOUT", time.Minute); got != tt.expected {
+				t.Fatalf("ParseDurationEnv(%q) = %v, want %v", tt.envValue, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDurationEnvMax(t *testing.T) {
+	tests := []struct {
+		name      string
+		envValue  string
+		want      time.Duration
+		wantErr   string
+		strictErr string
+	}{
+		{name: "unset uses default", envValue: "", want: 30 * time.Second},
+		{name: "within cap", envValue: "10m", want: 10 * time.Minute},
+		{name: "exactly at cap", envValue: "1h", want: time.Hour},
+		{name: "over cap is clamped or rejected", envValue: "99h", want: time.Hour, strictErr: "exceeds maximum 1h0m0s"},
+		{name: "zero", envValue: "0s", want: 0},
+		{name: "negative", envValue: "-1s", wantErr: "must not be negative"},
+		{name: "invalid", envValue: "forever", wantErr: "invalid duration"},
+	}
+
+	variants := []struct {
+		name   string
+		parse  func(string, time.Duration, time.Duration) (time.Duration, error)
+		strict bool
+	}{
+		{"clamping", ParseDurationEnvMax, false},
+		{"strict", ParseDurationEnvMaxStrict, true},
+	}
+
+	for _, p := range variants {
+		for _, tt := range tests {
+			t.Run(p.name+"/"+tt.name, func(t *testing.T) {
+				t.Setenv("TEST_TIMEOUT", tt.envValue)
+
+				wantErr := tt.wantErr
+				if p.strict && tt.strictErr != "" {
+					wantErr = tt.strictErr
+				}
+
+				got, err := p.parse("TEST_TIMEOUT", 30*time.Second, time.Hour)
+				if wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), wantErr) {
+						t.Fatalf("expected error containing %q, got %v", wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != tt.want {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			})
+		}
+
+		t.Run(p.name+"/negative max", func(t *testing.T) {
+			t.Setenv("TEST_TIMEOUT", "10s")
+
+			if _, err := p.parse("TEST_TIMEOUT", 0, -time.Second); err == nil || !strings.Contains(err.Error(), "max duration for TEST_TIMEOUT must not be negative") {
+				t.Fatalf("expected negative max error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParseDurationEnvUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		unit     time.Duration
+		want     time.Duration
+		wantErr  string
+	}{
+		{name: "unset uses default", envValue: "", unit: time.Second, want: time.Minute},
+		{name: "bare number in seconds", envValue: "300", unit: time.Second, want: 5 * time.Minute},
+		{name: "bare number in milliseconds", envValue: " 250 ", unit: time.Millisecond, want: 250 * time.Millisecond},
+		{name: "bare zero", envValue: "0", unit: time.Second, want: 0},
+		{name: "suffixed minutes", envValue: "5m", unit: time.Second, want: 5 * time.Minute},
+		{name: "compound duration", envValue: "1h30m", unit: time.Second, want: 90 * time.Minute},
+		{name: "suffixed ignores unit", envValue: "10s", unit: time.Hour, want: 10 * time.Second},
+		{name: "invalid", envValue: "soon", unit: time.Second, wantErr: "invalid duration"},
+		{name: "decimal without unit", envValue: "1.5", unit: time.Second, wantErr: "invalid duration"},
+		{name: "overflow", envValue: "9223372036854775807", unit: time.Second, wantErr: "overflows"},
+		{name: "negative bare number", envValue: "-300", unit: time.Second, wantErr: "must not be negative"},
+		{name: "negative suffixed", envValue: "-5m", unit: time.Second, wantErr: "must not be negative"},
+		{name: "negative zero", envValue: "-0", unit: time.Second, want: 0},
+		{name: "non-positive unit", envValue: "5", unit: 0, wantErr: "must be positive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_TTL", tt.envValue)
+
+			got, err := ParseDurationEnvUnit("TEST_TTL", tt.unit, time.Minute)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
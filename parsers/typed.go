@@ -0,0 +1,48 @@
+package parsers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ParseTypedEnvByKey parses an environment variable according to the type
+// suffix of its name (matched case-insensitively):
+//   - "_INT"   => int
+//   - "_BOOL"  => bool (case-insensitive)
+//   - "_FLOAT" => float64
+//   - anything else => string
+//
+// The value is trimmed before parsing.
+// Returns an error if the variable is unset or empty, or the value does not match the type.
+func ParseTypedEnvByKey(envVar string) (any, error) {
+	val := strings.TrimSpace(os.Getenv(envVar))
+	if val == "" {
+		return nil, fmt.Errorf("environment variable %s is required", envVar)
+	}
+
+	key := strings.ToUpper(envVar)
+	switch {
+	case strings.HasSuffix(key, "_INT"):
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer: %q", envVar, val)
+		}
+		return n, nil
+	case strings.HasSuffix(key, "_BOOL"):
+		b, err := parseBoolLenient(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a boolean: %q", envVar, val)
+		}
+		return b, nil
+	case strings.HasSuffix(key, "_FLOAT"):
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a float: %q", envVar, val)
+		}
+		return f, nil
+	default:
+		return val, nil
+	}
+}
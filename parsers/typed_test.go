@@ -0,0 +1,47 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTypedEnvByKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		envKey   string
+		envValue string
+		want     any
+		wantErr  string
+	}{
+		{name: "int suffix", envKey: "TEST_RETRIES_INT", envValue: " 3 ", want: 3},
+		{name: "negative int", envKey: "TEST_OFFSET_INT", envValue: "-2", want: -2},
+		{name: "bool suffix", envKey: "TEST_DEBUG_BOOL", envValue: "TRUE", want: true},
+		{name: "float suffix", envKey: "TEST_RATE_FLOAT", envValue: "0.25", want: 0.25},
+		{name: "lowercase suffix", envKey: "test_retries_int", envValue: "5", want: 5},
+		{name: "no suffix is string", envKey: "TEST_NAME", envValue: "  hello ", want: "hello"},
+		{name: "int mismatch", envKey: "TEST_RETRIES_INT", envValue: "abc", wantErr: "must be an integer"},
+		{name: "bool mismatch", envKey: "TEST_DEBUG_BOOL", envValue: "maybe", wantErr: "must be a boolean"},
+		{name: "float mismatch", envKey: "TEST_RATE_FLOAT", envValue: "fast", wantErr: "must be a float"},
+		{name: "unset", envKey: "TEST_RETRIES_INT", envValue: "", wantErr: "required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.envKey, tt.envValue)
+
+			got, err := ParseTypedEnvByKey(tt.envKey)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}